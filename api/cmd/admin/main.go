@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/postgres"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+// admin sets role of user, e.g. promotes the first operator to admin. Role is applied
+// to signed in user on the next tokens refresh.
+func main() {
+	username := flag.String("username", "", "username of user")
+	role := flag.String("role", authorization.RoleAdmin, "role: student, counselor or admin")
+	flag.Parse()
+
+	if *username == "" {
+		logrus.Fatal("username is required")
+	}
+
+	switch *role {
+	case authorization.RoleStudent, authorization.RoleCounselor, authorization.RoleAdmin:
+	default:
+		logrus.Fatalf("unknown role: %s", *role)
+	}
+
+	if err := setRole(*username, *role); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+func setRole(username string, role string) error {
+	db, err := postgres.NewDB(config.Get().DB)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	ctx := context.Background()
+	users := postgres.NewUserImpl(db)
+
+	user, err := users.GetUserByUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("error while getting user %s: %w", username, err)
+	}
+
+	if err := users.SetRole(ctx, user.ID, role); err != nil {
+		return err
+	}
+
+	fmt.Printf("user %s (id %d) is %s now\n", user.Username, user.ID, role)
+
+	return nil
+}
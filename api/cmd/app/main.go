@@ -1,17 +1,28 @@
 package main
 
 import (
-	"github.com/go-playground/validator/v10"
 	_ "github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/app"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache/redis"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/bot"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/graphql"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/grpc"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/http"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/postgres"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/worker"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/mailer"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/oidc"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/telegram"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/validation"
 )
 
 // @title Rating List Monitoring Platform
@@ -33,13 +44,9 @@ func main() {
 }
 
 func configureLogger() {
-	customFormatter := new(logrus.TextFormatter)
-	customFormatter.ForceColors = true
-	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
-	customFormatter.FullTimestamp = true
-
-	logrus.SetLevel(logrus.DebugLevel)
-	logrus.SetFormatter(customFormatter)
+	if err := logging.Configure(config.Get().Logging); err != nil {
+		logrus.Fatal(err)
+	}
 }
 
 // nolint:errcheck
@@ -50,17 +57,44 @@ func buildContainer() *dig.Container {
 	container.Provide(func() *config.Cache { return config.Get().Cache })
 	container.Provide(func() *config.DB { return config.Get().DB })
 	container.Provide(func() *config.Server { return config.Get().Server })
+	container.Provide(func() *config.GRPC { return config.Get().GRPC })
+	container.Provide(func() *config.Email { return config.Get().Email })
+	container.Provide(func() *config.Telegram { return config.Get().Telegram })
+	container.Provide(func() *config.Revocation { return config.Get().Revocation })
+	container.Provide(func() *config.AuthTokens { return config.Get().AuthTokens })
+	container.Provide(func() *config.OIDC { return config.Get().OIDC })
+	container.Provide(func() *config.Encryption { return config.Get().Encryption })
+	container.Provide(func() *config.Tracing { return config.Get().Tracing })
 
+	container.Provide(tracing.NewProvider)
 	container.Provide(redis.NewClient)
 	container.Provide(redis.NewCache)
 	container.Provide(postgres.NewDB)
 	container.Provide(postgres.NewRepository)
+	container.Provide(mailer.New)
+	container.Provide(func(cfg *config.Email) (*mailer.Templates, error) {
+		return mailer.LoadTemplates(cfg.TemplatesPath, cfg.DefaultLanguage)
+	})
+	container.Provide(telegram.NewClient)
+	container.Provide(authorization.NewJWT)
+	container.Provide(oidc.NewProviders)
+	container.Provide(encryption.NewSnils)
+	container.Provide(encryption.NewTOTPSecrets)
 	container.Provide(services.New)
-	container.Provide(validator.New)
+	container.Provide(validation.NewValidator)
+	container.Provide(graphql.NewExecutor)
 	container.Provide(http.NewHandler)
-	container.Provide(func(cfg *config.Server, handler *http.Handler) *http.Server {
-		return http.NewServer(cfg, handler.InitRoutes())
+	container.Provide(func(cfg *config.Server, handler *http.Handler) (*http.Server, error) {
+		router, err := handler.InitRoutes(cfg.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+
+		return http.NewServer(cfg, router), nil
 	})
+	container.Provide(grpc.NewServer)
+	container.Provide(worker.NewPool)
+	container.Provide(bot.New)
 
 	container.Provide(app.New)
 
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/postgres"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+)
+
+var errNotEncrypted = errors.New("snils of some users isn't encrypted, see log above")
+
+// encryptsnils encrypts SNILS stored in plain text and encrypts again SNILS encrypted with
+// previous keys or not bound to user id, so it should be run after migrations and after
+// every key rotation.
+// Rows already encrypted with the current key are skipped, so it can be run repeatedly.
+func main() {
+	if err := encryptSnils(); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+func encryptSnils() error {
+	cfg := config.Get()
+
+	snils, err := encryption.NewSnils(cfg.Encryption)
+	if err != nil {
+		return err
+	}
+
+	db, err := postgres.NewDB(cfg.DB)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	ctx := context.Background()
+	users := postgres.NewUserImpl(db)
+
+	rows, err := users.GetAllSnils(ctx)
+	if err != nil {
+		return err
+	}
+
+	var encrypted, failed int
+
+	for _, row := range rows {
+		if snils.IsCurrent(row.ID, row.Snils) {
+			continue
+		}
+
+		if err := encryptRow(ctx, users, snils, row); err != nil {
+			logrus.Errorf("user %d: %s", row.ID, err)
+
+			failed++
+
+			continue
+		}
+
+		encrypted++
+	}
+
+	fmt.Printf("encrypted snils of %d users, failed %d\n", encrypted, failed)
+
+	if failed != 0 {
+		return errNotEncrypted
+	}
+
+	return nil
+}
+
+// encryptRow encrypts SNILS of user with the current key. Value without ciphertext format
+// is considered plain text SNILS stored before encryption, value which isn't bound to user
+// is decrypted as SNILS encrypted before binding.
+func encryptRow(ctx context.Context, users *postgres.UserImpl, snils *encryption.Snils, row rdto.UserSnils) error {
+	plaintext := row.Snils
+
+	if encryption.IsEncrypted(row.Snils) {
+		decrypted, err := snils.Decrypt(row.ID, row.Snils)
+		if err != nil {
+			decrypted, err = snils.DecryptUnbound(row.Snils)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		plaintext = decrypted
+	}
+
+	encrypted, err := snils.Encrypt(row.ID, plaintext)
+	if err != nil {
+		return err
+	}
+
+	if err := users.PatchUser(ctx, row.ID, rdto.UserPatching{
+		Snils:     &encrypted.Ciphertext,
+		SnilsHash: &encrypted.Hash,
+		SnilsMask: &encrypted.Mask,
+	}); err != nil {
+		return fmt.Errorf("error while saving encrypted snils, it may be used by another user: %w", err)
+	}
+
+	return nil
+}
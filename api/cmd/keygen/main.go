@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const (
+	keyIDTimeFormat  = "20060102150405"
+	keyIDSuffixBytes = 4
+	keyDirPerm       = 0o700
+	keyFilePerm      = 0o600
+	defaultRSABits   = 2048
+)
+
+// keygen generates private key signing tokens into keys directory. Key id consists of
+// creation time, so the new key becomes signing one after auth.keys.publish_window, unless
+// auth.keys.signing_key_id pins another key. With -secret flag it prints random base64 key
+// for .env secrets instead (HS256 token secrets, encryption keys, blind index key and email links secret).
+func main() {
+	algorithm := flag.String("alg", authorization.AlgorithmEdDSA, "key algorithm: EdDSA or RS256")
+	bits := flag.Int("bits", defaultRSABits, "RSA key size")
+	dir := flag.String("dir", "keys", "keys directory")
+	secret := flag.Bool("secret", false, "print random base64 secret instead of generating signing key")
+	flag.Parse()
+
+	if *secret {
+		key, err := random.Bytes(encryption.KeySize)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
+		fmt.Println(base64.StdEncoding.EncodeToString(key))
+
+		return
+	}
+
+	suffix, err := random.Hex(keyIDSuffixBytes)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	keyID := fmt.Sprintf("%s-%s", time.Now().UTC().Format(keyIDTimeFormat), suffix)
+
+	key, err := authorization.GenerateKey(keyID, *algorithm, *bits)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	data, err := authorization.MarshalPrivateKeyPEM(key)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	if err := os.MkdirAll(*dir, keyDirPerm); err != nil {
+		logrus.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(*dir, keyID+".pem"), data, keyFilePerm); err != nil {
+		logrus.Fatal(err)
+	}
+
+	fmt.Println(keyID)
+}
@@ -10,36 +10,81 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/bot"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/grpc"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/http"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/worker"
 )
 
 type App struct {
 	server      *http.Server
+	grpcServer  *grpc.Server
+	workers     *worker.Pool
+	bot         *bot.Bot
+	cache       *cache.Cache
 	redisClient *redis.Client
 	postgresDB  *sqlx.DB
+	tracing     *tracing.Provider
 	cfg         *config.Config
 }
 
-func New(server *http.Server, redisClient *redis.Client, postgresDB *sqlx.DB, cfg *config.Config) *App {
+func New(
+	server *http.Server,
+	grpcServer *grpc.Server,
+	workers *worker.Pool,
+	bot *bot.Bot,
+	cache *cache.Cache,
+	redisClient *redis.Client,
+	postgresDB *sqlx.DB,
+	tracing *tracing.Provider,
+	cfg *config.Config,
+) *App {
 	return &App{
 		server:      server,
+		grpcServer:  grpcServer,
+		workers:     workers,
+		bot:         bot,
+		cache:       cache,
 		redisClient: redisClient,
 		postgresDB:  postgresDB,
+		tracing:     tracing,
 		cfg:         cfg,
 	}
 }
 
 func (a *App) Deploy() {
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	a.workers.Run(workersCtx)
+	a.bot.Run(workersCtx)
+
+	blacklistSynced := make(chan struct{})
+
+	go func() {
+		defer close(blacklistSynced)
+		a.cache.Blacklist.Sync(workersCtx)
+	}()
+
 	go func() {
 		if err := a.server.Run(); err != nil {
 			logrus.Fatalf("error occurred while running the server: %s", err)
 		}
 	}()
 
-	logrus.Infof("rating list monitoring platform api is starting on port=%s", a.cfg.Server.Port)
+	go func() {
+		if err := a.grpcServer.Run(); err != nil {
+			logrus.Fatalf("error occurred while running the grpc server: %s", err)
+		}
+	}()
+
+	logrus.Infof(
+		"rating list monitoring platform api is starting on port=%s, grpc port=%s",
+		a.cfg.Server.Port, a.cfg.GRPC.Port,
+	)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
@@ -51,6 +96,15 @@ func (a *App) Deploy() {
 		logrus.Errorf("error occurred on server shutting down: %s", err)
 	}
 
+	if err := a.grpcServer.Shutdown(context.Background()); err != nil {
+		logrus.Errorf("error occurred on grpc server shutting down: %s", err)
+	}
+
+	stopWorkers()
+	a.workers.Wait()
+	a.bot.Wait()
+	<-blacklistSynced
+
 	if err := a.redisClient.Close(); err != nil {
 		logrus.Errorf("error occurred on closing cache connection: %s", err)
 	}
@@ -58,4 +112,8 @@ func (a *App) Deploy() {
 	if err := a.postgresDB.Close(); err != nil {
 		logrus.Errorf("error occurred on closing db connection: %s", err)
 	}
+
+	if err := a.tracing.Shutdown(context.Background()); err != nil {
+		logrus.Errorf("error occurred on exporting spans: %s", err)
+	}
 }
@@ -1,26 +1,98 @@
 package cache
 
-import "time"
+import (
+	"context"
+	"time"
 
-type RefreshToken interface {
-	Save(userID uint, token string, ttl time.Duration) error
-	Get(userID uint) (string, error)
-	Delete(userID uint) error
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type Session interface {
+	Save(ctx context.Context, session models.Session, ttl time.Duration) error
+	Get(ctx context.Context, sessionID string) (*models.Session, error)
+	GetForUser(ctx context.Context, userID uint) ([]models.Session, error)
+	Rotate(ctx context.Context, session models.Session, previousRefreshTokenID string, ttl time.Duration) (bool, error)
+	Delete(ctx context.Context, userID uint, sessionID string) error
+	DeleteForUser(ctx context.Context, userID uint) error
 }
 
 type Blacklist interface {
-	Save(userID uint, accessToken string, ttl time.Duration) error
-	Get(userID uint) error
-	Delete(userID uint) error
+	Save(ctx context.Context, tokenID string, ttl time.Duration) error
+	Contains(ctx context.Context, tokenID string) (bool, error)
+	Sync(ctx context.Context)
+}
+
+type PasswordReset interface {
+	Save(ctx context.Context, tokenHash string, userID uint, ttl time.Duration) error
+	Pop(ctx context.Context, tokenHash string) (uint, error)
+	DeleteForUser(ctx context.Context, userID uint) error
+}
+
+type OIDCState interface {
+	Save(ctx context.Context, state string, data models.OIDCState, ttl time.Duration) error
+	Pop(ctx context.Context, state string) (*models.OIDCState, error)
 }
 
 type RatingList interface {
-	Save(url string, data string, ttl time.Duration) error
-	Get(url string) (string, error)
+	Save(ctx context.Context, url string, data string, ttl time.Duration) error
+	Get(ctx context.Context, url string) (string, error)
+	Purge(ctx context.Context) (int64, error)
+}
+
+type EmailVerification interface {
+	Save(ctx context.Context, token string, userID uint, email string, ttl time.Duration) error
+	Get(ctx context.Context, token string) (uint, string, error)
+	Delete(ctx context.Context, token string) error
+	DeleteForUser(ctx context.Context, userID uint) error
+}
+
+type RateLimit interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
+
+type SignInAttempts interface {
+	HitIP(ctx context.Context, ip string, limit int, window time.Duration) (time.Duration, error)
+	AddFailure(ctx context.Context, username string, window time.Duration) (int64, error)
+	ResetFailures(ctx context.Context, username string) error
+	Delay(ctx context.Context, username string, ttl time.Duration) error
+	GetDelay(ctx context.Context, username string) (time.Duration, error)
+	Lock(ctx context.Context, username string, ttl time.Duration) error
+	GetLock(ctx context.Context, username string) (time.Duration, error)
+	DeleteForUsername(ctx context.Context, username string) error
+}
+
+type TwoFactor interface {
+	SaveChallenge(ctx context.Context, token string, userID uint, ttl time.Duration) error
+	AttemptChallenge(ctx context.Context, token string) (uint, int64, error)
+	DeleteChallenge(ctx context.Context, token string) error
+	UseCode(ctx context.Context, userID uint, step int64, ttl time.Duration) (bool, error)
+	DeleteForUser(ctx context.Context, userID uint) error
+}
+
+type TelegramLinkCode interface {
+	Save(ctx context.Context, code string, userID uint, ttl time.Duration) error
+	Get(ctx context.Context, code string) (uint, error)
+	Delete(ctx context.Context, code string) error
+	DeleteForUser(ctx context.Context, userID uint) error
+}
+
+type LiveUpdates interface {
+	Publish(ctx context.Context, userID uint, payload string, ttl time.Duration) error
+	GetLatest(ctx context.Context, userID uint) (string, error)
+	DeleteLatest(ctx context.Context, userID uint) error
+	Subscribe(ctx context.Context, userID uint) (<-chan string, error)
 }
 
 type Cache struct {
-	RefreshToken
+	Session
 	Blacklist
+	PasswordReset
+	OIDCState
 	RatingList
+	EmailVerification
+	RateLimit
+	SignInAttempts
+	TwoFactor
+	TelegramLinkCode
+	LiveUpdates
 }
@@ -1,45 +1,220 @@
 package redis
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
-	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/bloom"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+const (
+	blacklistChannel             = "bl_revoked"
+	blacklistScanSize            = 1000
+	blacklistHealthCheckInterval = 30 * time.Second
+	blacklistResubscribeDelay    = time.Second
 )
 
+// BlacklistImpl stores ids of revoked tokens until their expiration. If bloom filter is
+// enabled, ids are also kept by in process filter, which is synchronized with other API
+// instances by Redis pub/sub and rebuilt on every subscription and periodically to forget
+// expired ids, so checks of not revoked tokens don't need Redis lookups.
 type BlacklistImpl struct {
-	rc *redis.Client
+	rc     *redis.Client
+	cfg    *config.Revocation
+	mu     sync.RWMutex
+	filter *bloom.Filter
+	next   *bloom.Filter
+	logger *logging.Logger
 }
 
-func NewBlacklistImpl(rc *redis.Client) *BlacklistImpl {
-	return &BlacklistImpl{rc}
+func NewBlacklistImpl(rc *redis.Client, cfg *config.Revocation) *BlacklistImpl {
+	return &BlacklistImpl{
+		rc:     rc,
+		cfg:    cfg,
+		logger: logging.NewLogger("blacklist cache"),
+	}
 }
 
-func (b *BlacklistImpl) Save(userID uint, accessToken string, ttl time.Duration) error {
-	if err := b.rc.Set(redisCtx, b.formatKey(userID), accessToken, ttl).Err(); err != nil {
-		return fmt.Errorf("error while saving user in blacklist cache: %w", err)
+func (b *BlacklistImpl) Save(ctx context.Context, tokenID string, ttl time.Duration) error {
+	if _, err := b.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, b.formatKey(tokenID), 1, ttl)
+		pipe.Publish(ctx, blacklistChannel, tokenID)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while saving token in blacklist cache: %w", err)
 	}
 
+	b.add(tokenID)
+
 	return nil
 }
 
-func (b *BlacklistImpl) Get(userID uint) error {
-	if err := b.rc.Get(redisCtx, b.formatKey(userID)).Err(); err != nil {
-		return errors.New("there is no user in the blacklist")
+func (b *BlacklistImpl) Contains(ctx context.Context, tokenID string) (bool, error) {
+	b.mu.RLock()
+	filter := b.filter
+	b.mu.RUnlock()
+
+	if filter != nil && !filter.Test(tokenID) {
+		return false, nil
 	}
 
-	return nil
+	count, err := b.rc.Exists(ctx, b.formatKey(tokenID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("error while checking token in blacklist cache: %w", err)
+	}
+
+	return count > 0, nil
 }
 
-func (b *BlacklistImpl) Delete(userID uint) error {
-	if err := b.rc.Del(redisCtx, b.formatKey(userID)).Err(); err != nil {
-		return fmt.Errorf("error while deleting user from blacklist cache: %w", err)
+// Sync keeps bloom filter up to date until context is canceled. Ids revoked while there is
+// no subscription are missed by pub/sub, so filter is dropped when subscription fails and
+// is rebuilt on every subscription. Until the filter is built, every check is done by Redis
+// lookup.
+func (b *BlacklistImpl) Sync(ctx context.Context) {
+	if !b.cfg.BloomFilterEnabled {
+		return
+	}
+
+	for {
+		err := b.subscribe(ctx)
+		b.drop()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		b.logger.Error(fmt.Errorf("error while receiving revoked tokens: %w", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(blacklistResubscribeDelay):
+		}
 	}
+}
+
+// subscribe adds revoked ids to filter until subscription fails, connection is checked by
+// ping if nothing is received for a while. Filter is rebuilt once subscription is confirmed
+// and then periodically to forget expired ids.
+func (b *BlacklistImpl) subscribe(ctx context.Context) error {
+	pubsub := b.rc.Subscribe(ctx, blacklistChannel)
+	defer pubsub.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = pubsub.Close()
+		case <-done:
+		}
+	}()
+
+	var rebuiltAt time.Time
+
+	pingSent := false
+
+	for {
+		if !rebuiltAt.IsZero() && time.Since(rebuiltAt) >= b.cfg.BloomFilterRebuildInterval {
+			if err := b.rebuild(ctx); err != nil {
+				return err
+			}
+
+			rebuiltAt = time.Now()
+		}
+
+		message, err := pubsub.ReceiveTimeout(ctx, blacklistHealthCheckInterval)
+		if err != nil {
+			var netErr net.Error
+			if pingSent || !errors.As(err, &netErr) || !netErr.Timeout() {
+				return err
+			}
+
+			if err := pubsub.Ping(ctx); err != nil {
+				return err
+			}
+
+			pingSent = true
+
+			continue
+		}
+
+		pingSent = false
+
+		switch message := message.(type) {
+		case *redis.Subscription:
+			if err := b.rebuild(ctx); err != nil {
+				return err
+			}
+
+			rebuiltAt = time.Now()
+		case *redis.Message:
+			b.add(message.Payload)
+		}
+	}
+}
+
+// rebuild fills new filter by revoked token ids from Redis and replaces the current one.
+// Ids revoked during the scan are added to both filters.
+func (b *BlacklistImpl) rebuild(ctx context.Context) error {
+	next := bloom.New(b.cfg.BloomFilterCapacity, b.cfg.BloomFilterFalsePositiveRate)
+
+	b.mu.Lock()
+	b.next = next
+	b.mu.Unlock()
+
+	prefixLength := len(b.formatKey(""))
+	iter := b.rc.Scan(ctx, 0, b.formatKey("*"), blacklistScanSize).Iterator()
+
+	for iter.Next(ctx) {
+		next.Add(iter.Val()[prefixLength:])
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.next = nil
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("error while scanning revoked tokens: %w", err)
+	}
+
+	b.filter = next
 
 	return nil
 }
 
-func (b *BlacklistImpl) formatKey(userID uint) string {
-	return fmt.Sprintf("bl_%d", userID)
+// drop drops filter, so checks are done by Redis lookups until filter is rebuilt.
+func (b *BlacklistImpl) drop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.filter = nil
+}
+
+func (b *BlacklistImpl) add(tokenID string) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.filter != nil {
+		b.filter.Add(tokenID)
+	}
+
+	if b.next != nil {
+		b.next.Add(tokenID)
+	}
+}
+
+func (b *BlacklistImpl) formatKey(tokenID string) string {
+	return fmt.Sprintf("bl_%s", tokenID)
 }
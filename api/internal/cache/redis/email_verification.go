@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	emailVerificationUserIDField = "user_id"
+	emailVerificationEmailField  = "email"
+)
+
+type EmailVerificationImpl struct {
+	rc *redis.Client
+}
+
+func NewEmailVerificationImpl(rc *redis.Client) *EmailVerificationImpl {
+	return &EmailVerificationImpl{rc}
+}
+
+func (e *EmailVerificationImpl) Save(
+	ctx context.Context,
+	token string,
+	userID uint,
+	email string,
+	ttl time.Duration,
+) error {
+	key := e.formatKey(token)
+
+	if _, err := e.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, emailVerificationUserIDField, userID, emailVerificationEmailField, email)
+		pipe.Expire(ctx, key, ttl)
+		pipe.SAdd(ctx, e.formatUserKey(userID), token)
+		pipe.Expire(ctx, e.formatUserKey(userID), ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while caching email verification: %w", err)
+	}
+
+	return nil
+}
+
+func (e *EmailVerificationImpl) Get(ctx context.Context, token string) (uint, string, error) {
+	values, err := e.rc.HGetAll(ctx, e.formatKey(token)).Result()
+	if err != nil {
+		return 0, "", fmt.Errorf("error while getting email verification from cache: %w", err)
+	}
+
+	userID, err := strconv.ParseUint(values[emailVerificationUserIDField], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("there is no email verification in cache: %w", err)
+	}
+
+	return uint(userID), values[emailVerificationEmailField], nil
+}
+
+func (e *EmailVerificationImpl) Delete(ctx context.Context, token string) error {
+	if err := e.rc.Del(ctx, e.formatKey(token)).Err(); err != nil {
+		return fmt.Errorf("error while deleting email verification from cache: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForUser deletes all verification tokens of user.
+func (e *EmailVerificationImpl) DeleteForUser(ctx context.Context, userID uint) error {
+	tokens, err := e.rc.SMembers(ctx, e.formatUserKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("error while getting user email verifications from cache: %w", err)
+	}
+
+	keys := []string{e.formatUserKey(userID)}
+	for _, token := range tokens {
+		keys = append(keys, e.formatKey(token))
+	}
+
+	if err := e.rc.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("error while deleting user email verifications from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (e *EmailVerificationImpl) formatKey(token string) string {
+	return fmt.Sprintf("ev_%s", token)
+}
+
+func (e *EmailVerificationImpl) formatUserKey(userID uint) string {
+	return fmt.Sprintf("ev_user_%d", userID)
+}
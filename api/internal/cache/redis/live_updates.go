@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type LiveUpdatesImpl struct {
+	rc *redis.Client
+}
+
+func NewLiveUpdatesImpl(rc *redis.Client) *LiveUpdatesImpl {
+	return &LiveUpdatesImpl{rc}
+}
+
+// Publish saves payload as the latest user payload and sends it to user channel,
+// so it is received by subscribers connected to any API instance.
+func (l *LiveUpdatesImpl) Publish(ctx context.Context, userID uint, payload string, ttl time.Duration) error {
+	if _, err := l.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, l.formatLatestKey(userID), payload, ttl)
+		pipe.Publish(ctx, l.formatChannel(userID), payload)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while publishing live update: %w", err)
+	}
+
+	return nil
+}
+
+func (l *LiveUpdatesImpl) GetLatest(ctx context.Context, userID uint) (string, error) {
+	payload, err := l.rc.Get(ctx, l.formatLatestKey(userID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("error while getting latest live update from cache: %w", err)
+	}
+
+	return payload, nil
+}
+
+func (l *LiveUpdatesImpl) DeleteLatest(ctx context.Context, userID uint) error {
+	if err := l.rc.Del(ctx, l.formatLatestKey(userID)).Err(); err != nil {
+		return fmt.Errorf("error while deleting latest live update from cache: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe returns channel of payloads published to user channel. It is closed
+// after context is canceled.
+func (l *LiveUpdatesImpl) Subscribe(ctx context.Context, userID uint) (<-chan string, error) {
+	pubsub := l.rc.Subscribe(ctx, l.formatChannel(userID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+
+		return nil, fmt.Errorf("error while subscribing to live updates: %w", err)
+	}
+
+	payloads := make(chan string)
+
+	go func() {
+		defer close(payloads)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				select {
+				case payloads <- message.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return payloads, nil
+}
+
+func (l *LiveUpdatesImpl) formatChannel(userID uint) string {
+	return fmt.Sprintf("lu_%d", userID)
+}
+
+func (l *LiveUpdatesImpl) formatLatestKey(userID uint) string {
+	return fmt.Sprintf("lu_latest_%d", userID)
+}
@@ -0,0 +1,86 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+const (
+	oidcStateProviderField     = "provider"
+	oidcStateNonceField        = "nonce"
+	oidcStateCodeVerifierField = "code_verifier"
+	oidcStateUserIDField       = "user_id"
+)
+
+type OIDCStateImpl struct {
+	rc *redis.Client
+}
+
+func NewOIDCStateImpl(rc *redis.Client) *OIDCStateImpl {
+	return &OIDCStateImpl{rc}
+}
+
+func (o *OIDCStateImpl) Save(ctx context.Context, state string, data models.OIDCState, ttl time.Duration) error {
+	key := o.formatKey(state)
+
+	if _, err := o.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(
+			ctx, key,
+			oidcStateProviderField, data.Provider,
+			oidcStateNonceField, data.Nonce,
+			oidcStateCodeVerifierField, data.CodeVerifier,
+			oidcStateUserIDField, data.UserID,
+		)
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while caching oidc state: %w", err)
+	}
+
+	return nil
+}
+
+// Pop returns authorization state and deletes it, so state can be used only once.
+func (o *OIDCStateImpl) Pop(ctx context.Context, state string) (*models.OIDCState, error) {
+	key := o.formatKey(state)
+
+	var get *redis.StringStringMapCmd
+
+	if _, err := o.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error while getting oidc state from cache: %w", err)
+	}
+
+	values := get.Val()
+	if len(values) == 0 {
+		return nil, errors.New("oidc state not found")
+	}
+
+	userID, err := strconv.ParseUint(values[oidcStateUserIDField], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing oidc state user id: %w", err)
+	}
+
+	return &models.OIDCState{
+		Provider:     values[oidcStateProviderField],
+		Nonce:        values[oidcStateNonceField],
+		CodeVerifier: values[oidcStateCodeVerifierField],
+		UserID:       uint(userID),
+	}, nil
+}
+
+func (o *OIDCStateImpl) formatKey(state string) string {
+	return fmt.Sprintf("oidc_%s", state)
+}
@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type PasswordResetImpl struct {
+	rc *redis.Client
+}
+
+func NewPasswordResetImpl(rc *redis.Client) *PasswordResetImpl {
+	return &PasswordResetImpl{rc}
+}
+
+// Save stores user of reset token hash. The previous token of user is deleted, so only
+// the latest requested token can be used.
+func (p *PasswordResetImpl) Save(ctx context.Context, tokenHash string, userID uint, ttl time.Duration) error {
+	previousTokenHash, err := p.rc.Get(ctx, p.formatUserKey(userID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("error while getting previous password reset token from cache: %w", err)
+	}
+
+	if _, err := p.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if previousTokenHash != "" {
+			pipe.Del(ctx, p.formatKey(previousTokenHash))
+		}
+
+		pipe.Set(ctx, p.formatKey(tokenHash), userID, ttl)
+		pipe.Set(ctx, p.formatUserKey(userID), tokenHash, ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while caching password reset token: %w", err)
+	}
+
+	return nil
+}
+
+// Pop returns user of reset token hash and deletes it, so token can be used only once.
+func (p *PasswordResetImpl) Pop(ctx context.Context, tokenHash string) (uint, error) {
+	var get *redis.StringCmd
+
+	if _, err := p.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, p.formatKey(tokenHash))
+		pipe.Del(ctx, p.formatKey(tokenHash))
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("error while getting password reset token from cache: %w", err)
+	}
+
+	userID, err := strconv.ParseUint(get.Val(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error while parsing password reset token user id: %w", err)
+	}
+
+	if err := p.rc.Del(ctx, p.formatUserKey(uint(userID))).Err(); err != nil {
+		return 0, fmt.Errorf("error while deleting password reset token from cache: %w", err)
+	}
+
+	return uint(userID), nil
+}
+
+// DeleteForUser deletes reset token of user, if any.
+func (p *PasswordResetImpl) DeleteForUser(ctx context.Context, userID uint) error {
+	tokenHash, err := p.rc.Get(ctx, p.formatUserKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while getting password reset token from cache: %w", err)
+	}
+
+	if err := p.rc.Del(ctx, p.formatKey(tokenHash), p.formatUserKey(userID)).Err(); err != nil {
+		return fmt.Errorf("error while deleting password reset token from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PasswordResetImpl) formatKey(tokenHash string) string {
+	return fmt.Sprintf("pr_%s", tokenHash)
+}
+
+func (p *PasswordResetImpl) formatUserKey(userID uint) string {
+	return fmt.Sprintf("pr_user_%d", userID)
+}
@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// fixedWindowScript increments hits counter and starts window on the first hit.
+var fixedWindowScript = redis.NewScript(`
+local hits = redis.call("INCR", KEYS[1])
+if hits == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return hits
+`)
+
+type RateLimitImpl struct {
+	rc *redis.Client
+}
+
+func NewRateLimitImpl(rc *redis.Client) *RateLimitImpl {
+	return &RateLimitImpl{rc}
+}
+
+// Allow counts hit of key in fixed window and reports whether hits count doesn't exceed limit.
+func (r *RateLimitImpl) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	hits, err := fixedWindowScript.Run(ctx, r.rc, []string{r.formatKey(key)}, window.Milliseconds()).Int64()
+	if err != nil {
+		return false, fmt.Errorf("error while counting rate limit hit: %w", err)
+	}
+
+	return hits <= int64(limit), nil
+}
+
+func (r *RateLimitImpl) formatKey(key string) string {
+	return fmt.Sprintf("lim_%s", key)
+}
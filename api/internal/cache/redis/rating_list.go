@@ -1,12 +1,15 @@
 package redis
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 )
 
+const ratingListScanSize = 100
+
 type RatingListImpl struct {
 	rc *redis.Client
 }
@@ -15,16 +18,16 @@ func NewRatingListImpl(rc *redis.Client) *RatingListImpl {
 	return &RatingListImpl{rc: rc}
 }
 
-func (r *RatingListImpl) Save(url string, data string, ttl time.Duration) error {
-	if err := r.rc.Set(redisCtx, r.formatKey(url), data, ttl).Err(); err != nil {
+func (r *RatingListImpl) Save(ctx context.Context, url string, data string, ttl time.Duration) error {
+	if err := r.rc.Set(ctx, r.formatKey(url), data, ttl).Err(); err != nil {
 		return fmt.Errorf("error while caching rating list: %w", err)
 	}
 
 	return nil
 }
 
-func (r *RatingListImpl) Get(url string) (string, error) {
-	ratingList, err := r.rc.Get(redisCtx, r.formatKey(url)).Result()
+func (r *RatingListImpl) Get(ctx context.Context, url string) (string, error) {
+	ratingList, err := r.rc.Get(ctx, r.formatKey(url)).Result()
 	if err != nil {
 		return "", fmt.Errorf("error while getting rating list from cache: %w", err)
 	}
@@ -32,6 +35,27 @@ func (r *RatingListImpl) Get(url string) (string, error) {
 	return ratingList, nil
 }
 
+// Purge deletes all cached rating lists, so they are parsed again on the next request.
+func (r *RatingListImpl) Purge(ctx context.Context) (int64, error) {
+	var deleted int64
+
+	iter := r.rc.Scan(ctx, 0, r.formatKey("*"), ratingListScanSize).Iterator()
+	for iter.Next(ctx) {
+		n, err := r.rc.Del(ctx, iter.Val()).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("error while deleting rating list from cache: %w", err)
+		}
+
+		deleted += n
+	}
+
+	if err := iter.Err(); err != nil {
+		return deleted, fmt.Errorf("error while scanning rating lists: %w", err)
+	}
+
+	return deleted, nil
+}
+
 func (r *RatingListImpl) formatKey(url string) string {
 	return fmt.Sprintf("rl_%s", url)
 }
@@ -1,31 +1,37 @@
 package redis
 
 import (
-	"context"
-
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
 
+	"github.com/go-redis/redis/extra/redisotel/v8"
 	"github.com/go-redis/redis/v8"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
 )
 
-var redisCtx = context.TODO()
-
 func NewClient(cfg *config.Cache) *redis.Client {
 	rc := redis.NewClient(&redis.Options{
 		Addr:     cfg.Address,
 		Password: cfg.Password,
 		DB:       cfg.DB,
 	})
+	rc.AddHook(redisotel.NewTracingHook())
 
 	return rc
 }
 
-func NewCache(rc *redis.Client) *cache.Cache {
+func NewCache(rc *redis.Client, revocationCfg *config.Revocation) *cache.Cache {
 	return &cache.Cache{
-		RefreshToken: NewRefreshTokenImpl(rc),
-		Blacklist:    NewBlacklistImpl(rc),
-		RatingList:   NewRatingListImpl(rc),
+		Session:           NewSessionImpl(rc),
+		Blacklist:         NewBlacklistImpl(rc, revocationCfg),
+		PasswordReset:     NewPasswordResetImpl(rc),
+		OIDCState:         NewOIDCStateImpl(rc),
+		RatingList:        NewRatingListImpl(rc),
+		EmailVerification: NewEmailVerificationImpl(rc),
+		RateLimit:         NewRateLimitImpl(rc),
+		SignInAttempts:    NewSignInAttemptsImpl(rc),
+		TwoFactor:         NewTwoFactorImpl(rc),
+		TelegramLinkCode:  NewTelegramLinkCodeImpl(rc),
+		LiveUpdates:       NewLiveUpdatesImpl(rc),
 	}
 }
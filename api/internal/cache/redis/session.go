@@ -0,0 +1,222 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+const (
+	sessionUserIDField         = "user_id"
+	sessionRefreshTokenIDField = "refresh_token_id"
+	sessionAccessTokenIDField  = "access_token_id"
+	sessionAccessTokenExpField = "access_token_expires_at"
+	sessionIPField             = "ip"
+	sessionUserAgentField      = "user_agent"
+	sessionCreatedAtField      = "created_at"
+	sessionLastUsedAtField     = "last_used_at"
+)
+
+var errSessionNotFound = errors.New("session not found")
+
+// rotateSessionScript replaces refresh token id of session only if it is equal to the
+// expected one, so concurrent refreshes by the same token can't both succeed. ARGV holds
+// refresh token id field, expected and new ids, ttl in milliseconds and pairs of other
+// fields to update.
+var rotateSessionScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[3], unpack(ARGV, 5))
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+return 1
+`)
+
+type SessionImpl struct {
+	rc *redis.Client
+}
+
+func NewSessionImpl(rc *redis.Client) *SessionImpl {
+	return &SessionImpl{rc}
+}
+
+func (s *SessionImpl) Save(ctx context.Context, session models.Session, ttl time.Duration) error {
+	if _, err := s.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, s.formatKey(session.ID), s.toValues(session))
+		pipe.Expire(ctx, s.formatKey(session.ID), ttl)
+		pipe.SAdd(ctx, s.formatUserKey(session.UserID), session.ID)
+		pipe.Expire(ctx, s.formatUserKey(session.UserID), ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while caching session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SessionImpl) Get(ctx context.Context, sessionID string) (*models.Session, error) {
+	values, err := s.rc.HGetAll(ctx, s.formatKey(sessionID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting session from cache: %w", err)
+	}
+
+	session, err := s.fromValues(sessionID, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// GetForUser returns active sessions of user. Expired sessions are removed from user
+// sessions set.
+func (s *SessionImpl) GetForUser(ctx context.Context, userID uint) ([]models.Session, error) {
+	sessionIDs, err := s.rc.SMembers(ctx, s.formatUserKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user sessions from cache: %w", err)
+	}
+
+	sessions := make([]models.Session, 0, len(sessionIDs))
+
+	for _, sessionID := range sessionIDs {
+		session, err := s.Get(ctx, sessionID)
+		if errors.Is(err, errSessionNotFound) {
+			if err := s.rc.SRem(ctx, s.formatUserKey(userID), sessionID).Err(); err != nil {
+				return nil, fmt.Errorf("error while deleting expired session from cache: %w", err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, *session)
+	}
+
+	return sessions, nil
+}
+
+func (s *SessionImpl) Rotate(
+	ctx context.Context,
+	session models.Session,
+	previousRefreshTokenID string,
+	ttl time.Duration,
+) (bool, error) {
+	rotated, err := rotateSessionScript.Run(
+		ctx, s.rc,
+		[]string{s.formatKey(session.ID), s.formatUserKey(session.UserID)},
+		sessionRefreshTokenIDField, previousRefreshTokenID, session.RefreshTokenID, ttl.Milliseconds(),
+		sessionAccessTokenIDField, session.AccessTokenID,
+		sessionAccessTokenExpField, session.AccessTokenExpiresAt.Unix(),
+		sessionIPField, session.IP,
+		sessionUserAgentField, session.UserAgent,
+		sessionLastUsedAtField, session.LastUsedAt.Unix(),
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("error while rotating session refresh token: %w", err)
+	}
+
+	return rotated == 1, nil
+}
+
+func (s *SessionImpl) Delete(ctx context.Context, userID uint, sessionID string) error {
+	if _, err := s.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, s.formatKey(sessionID))
+		pipe.SRem(ctx, s.formatUserKey(userID), sessionID)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while deleting session from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SessionImpl) DeleteForUser(ctx context.Context, userID uint) error {
+	sessionIDs, err := s.rc.SMembers(ctx, s.formatUserKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("error while getting user sessions from cache: %w", err)
+	}
+
+	keys := make([]string, 0, len(sessionIDs)+1)
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, s.formatKey(sessionID))
+	}
+
+	keys = append(keys, s.formatUserKey(userID))
+
+	if err := s.rc.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("error while deleting user sessions from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SessionImpl) toValues(session models.Session) map[string]interface{} {
+	return map[string]interface{}{
+		sessionUserIDField:         session.UserID,
+		sessionRefreshTokenIDField: session.RefreshTokenID,
+		sessionAccessTokenIDField:  session.AccessTokenID,
+		sessionAccessTokenExpField: session.AccessTokenExpiresAt.Unix(),
+		sessionIPField:             session.IP,
+		sessionUserAgentField:      session.UserAgent,
+		sessionCreatedAtField:      session.CreatedAt.Unix(),
+		sessionLastUsedAtField:     session.LastUsedAt.Unix(),
+	}
+}
+
+func (s *SessionImpl) fromValues(sessionID string, values map[string]string) (*models.Session, error) {
+	if len(values) == 0 {
+		return nil, errSessionNotFound
+	}
+
+	userID, err := strconv.ParseUint(values[sessionUserIDField], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing session user id: %w", err)
+	}
+
+	createdAt, err := strconv.ParseInt(values[sessionCreatedAtField], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing session creation time: %w", err)
+	}
+
+	lastUsedAt, err := strconv.ParseInt(values[sessionLastUsedAtField], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing session last usage time: %w", err)
+	}
+
+	accessTokenExpiresAt, err := strconv.ParseInt(values[sessionAccessTokenExpField], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing session access token expiration time: %w", err)
+	}
+
+	return &models.Session{
+		ID:                   sessionID,
+		UserID:               uint(userID),
+		RefreshTokenID:       values[sessionRefreshTokenIDField],
+		AccessTokenID:        values[sessionAccessTokenIDField],
+		AccessTokenExpiresAt: time.Unix(accessTokenExpiresAt, 0),
+		IP:                   values[sessionIPField],
+		UserAgent:            values[sessionUserAgentField],
+		CreatedAt:            time.Unix(createdAt, 0),
+		LastUsedAt:           time.Unix(lastUsedAt, 0),
+	}, nil
+}
+
+func (s *SessionImpl) formatKey(sessionID string) string {
+	return fmt.Sprintf("ss_%s", sessionID)
+}
+
+func (s *SessionImpl) formatUserKey(userID uint) string {
+	return fmt.Sprintf("ss_user_%d", userID)
+}
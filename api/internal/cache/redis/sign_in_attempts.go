@@ -0,0 +1,169 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// slidingWindowScript drops hits which are out of window and adds new hit if limit isn't
+// reached. It returns zero for allowed hit, otherwise milliseconds until the oldest hit
+// leaves window.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+if redis.call("ZCARD", KEYS[1]) < tonumber(ARGV[3]) then
+	redis.call("ZADD", KEYS[1], now, ARGV[4])
+	redis.call("PEXPIRE", KEYS[1], window)
+	return 0
+end
+local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+return tonumber(oldest[2]) + window - now
+`)
+
+// slidingCounterScript adds hit, drops hits which are out of window and returns hits count.
+var slidingCounterScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+redis.call("ZADD", KEYS[1], now, ARGV[3])
+redis.call("PEXPIRE", KEYS[1], window)
+return redis.call("ZCARD", KEYS[1])
+`)
+
+type SignInAttemptsImpl struct {
+	rc *redis.Client
+}
+
+func NewSignInAttemptsImpl(rc *redis.Client) *SignInAttemptsImpl {
+	return &SignInAttemptsImpl{rc}
+}
+
+// HitIP counts sign in attempt from ip in sliding window. It returns zero if attempt is
+// allowed, otherwise time until the next attempt is allowed.
+func (s *SignInAttemptsImpl) HitIP(
+	ctx context.Context,
+	ip string,
+	limit int,
+	window time.Duration,
+) (time.Duration, error) {
+	now := time.Now()
+
+	retryAfter, err := slidingWindowScript.Run(
+		ctx, s.rc, []string{s.formatIPKey(ip)},
+		now.UnixNano()/int64(time.Millisecond), window.Milliseconds(), limit, s.formatMember(now),
+	).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("error while counting sign in attempt: %w", err)
+	}
+
+	return time.Duration(retryAfter) * time.Millisecond, nil
+}
+
+// AddFailure counts failed sign in attempt of username and returns count of failed
+// attempts in sliding window.
+func (s *SignInAttemptsImpl) AddFailure(ctx context.Context, username string, window time.Duration) (int64, error) {
+	now := time.Now()
+
+	failures, err := slidingCounterScript.Run(
+		ctx, s.rc, []string{s.formatFailuresKey(username)},
+		now.UnixNano()/int64(time.Millisecond), window.Milliseconds(), s.formatMember(now),
+	).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("error while counting failed sign in attempt: %w", err)
+	}
+
+	return failures, nil
+}
+
+func (s *SignInAttemptsImpl) ResetFailures(ctx context.Context, username string) error {
+	if err := s.rc.Del(
+		ctx, s.formatFailuresKey(username), s.formatDelayKey(username),
+	).Err(); err != nil {
+		return fmt.Errorf("error while resetting failed sign in attempts: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForUsername deletes failed attempts, delay and lock of username.
+func (s *SignInAttemptsImpl) DeleteForUsername(ctx context.Context, username string) error {
+	if err := s.rc.Del(
+		ctx, s.formatFailuresKey(username), s.formatDelayKey(username), s.formatLockKey(username),
+	).Err(); err != nil {
+		return fmt.Errorf("error while deleting sign in attempts of username: %w", err)
+	}
+
+	return nil
+}
+
+// Delay forbids sign in attempts of username for ttl.
+func (s *SignInAttemptsImpl) Delay(ctx context.Context, username string, ttl time.Duration) error {
+	if err := s.rc.Set(ctx, s.formatDelayKey(username), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("error while delaying sign in attempts: %w", err)
+	}
+
+	return nil
+}
+
+// GetDelay returns time until the next sign in attempt of username is allowed.
+func (s *SignInAttemptsImpl) GetDelay(ctx context.Context, username string) (time.Duration, error) {
+	return s.getTTL(ctx, s.formatDelayKey(username))
+}
+
+// Lock locks username for ttl, failed attempts counted before are dropped.
+func (s *SignInAttemptsImpl) Lock(ctx context.Context, username string, ttl time.Duration) error {
+	if _, err := s.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, s.formatLockKey(username), 1, ttl)
+		pipe.Del(ctx, s.formatFailuresKey(username), s.formatDelayKey(username))
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while locking sign in: %w", err)
+	}
+
+	return nil
+}
+
+// GetLock returns time until username is unlocked.
+func (s *SignInAttemptsImpl) GetLock(ctx context.Context, username string) (time.Duration, error) {
+	return s.getTTL(ctx, s.formatLockKey(username))
+}
+
+// getTTL returns ttl of key, zero is returned if key doesn't exist.
+func (s *SignInAttemptsImpl) getTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.rc.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error while getting sign in block ttl: %w", err)
+	}
+
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+func (s *SignInAttemptsImpl) formatMember(now time.Time) string {
+	return strconv.FormatInt(now.UnixNano(), 10)
+}
+
+func (s *SignInAttemptsImpl) formatIPKey(ip string) string {
+	return fmt.Sprintf("sa_ip_%s", ip)
+}
+
+func (s *SignInAttemptsImpl) formatFailuresKey(username string) string {
+	return fmt.Sprintf("sa_fail_%s", username)
+}
+
+func (s *SignInAttemptsImpl) formatDelayKey(username string) string {
+	return fmt.Sprintf("sa_delay_%s", username)
+}
+
+func (s *SignInAttemptsImpl) formatLockKey(username string) string {
+	return fmt.Sprintf("sa_lock_%s", username)
+}
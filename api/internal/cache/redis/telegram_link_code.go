@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type TelegramLinkCodeImpl struct {
+	rc *redis.Client
+}
+
+func NewTelegramLinkCodeImpl(rc *redis.Client) *TelegramLinkCodeImpl {
+	return &TelegramLinkCodeImpl{rc}
+}
+
+func (t *TelegramLinkCodeImpl) Save(ctx context.Context, code string, userID uint, ttl time.Duration) error {
+	if _, err := t.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, t.formatKey(code), userID, ttl)
+		pipe.SAdd(ctx, t.formatUserKey(userID), code)
+		pipe.Expire(ctx, t.formatUserKey(userID), ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while caching telegram link code: %w", err)
+	}
+
+	return nil
+}
+
+func (t *TelegramLinkCodeImpl) Get(ctx context.Context, code string) (uint, error) {
+	value, err := t.rc.Get(ctx, t.formatKey(code)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error while getting telegram link code from cache: %w", err)
+	}
+
+	userID, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error while parsing telegram link code user id: %w", err)
+	}
+
+	return uint(userID), nil
+}
+
+func (t *TelegramLinkCodeImpl) Delete(ctx context.Context, code string) error {
+	if err := t.rc.Del(ctx, t.formatKey(code)).Err(); err != nil {
+		return fmt.Errorf("error while deleting telegram link code from cache: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForUser deletes all link codes of user.
+func (t *TelegramLinkCodeImpl) DeleteForUser(ctx context.Context, userID uint) error {
+	codes, err := t.rc.SMembers(ctx, t.formatUserKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("error while getting user telegram link codes from cache: %w", err)
+	}
+
+	keys := []string{t.formatUserKey(userID)}
+	for _, code := range codes {
+		keys = append(keys, t.formatKey(code))
+	}
+
+	if err := t.rc.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("error while deleting user telegram link codes from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (t *TelegramLinkCodeImpl) formatKey(code string) string {
+	return fmt.Sprintf("tg_%s", code)
+}
+
+func (t *TelegramLinkCodeImpl) formatUserKey(userID uint) string {
+	return fmt.Sprintf("tg_user_%d", userID)
+}
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// challengeAttemptScript counts attempt of existing challenge and returns its user id and
+// count of attempts, nil is returned if challenge doesn't exist.
+var challengeAttemptScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return nil
+end
+local attempts = redis.call("HINCRBY", KEYS[1], "attempts", 1)
+return {tonumber(redis.call("HGET", KEYS[1], "user_id")), attempts}
+`)
+
+const twoFactorScanSize = 100
+
+type TwoFactorImpl struct {
+	rc *redis.Client
+}
+
+func NewTwoFactorImpl(rc *redis.Client) *TwoFactorImpl {
+	return &TwoFactorImpl{rc}
+}
+
+func (t *TwoFactorImpl) SaveChallenge(ctx context.Context, token string, userID uint, ttl time.Duration) error {
+	if _, err := t.rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, t.formatChallengeKey(token), "user_id", userID, "attempts", 0)
+		pipe.PExpire(ctx, t.formatChallengeKey(token), ttl)
+		pipe.SAdd(ctx, t.formatUserChallengesKey(userID), token)
+		pipe.PExpire(ctx, t.formatUserChallengesKey(userID), ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while caching two factor challenge: %w", err)
+	}
+
+	return nil
+}
+
+// AttemptChallenge counts code attempt of challenge. It returns user of challenge and count
+// of attempts including this one.
+func (t *TwoFactorImpl) AttemptChallenge(ctx context.Context, token string) (uint, int64, error) {
+	result, err := challengeAttemptScript.Run(ctx, t.rc, []string{t.formatChallengeKey(token)}).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error while counting two factor challenge attempt: %w", err)
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return 0, 0, fmt.Errorf("unexpected two factor challenge attempt result: %v", result)
+	}
+
+	userID, _ := values[0].(int64)
+	attempts, _ := values[1].(int64)
+
+	return uint(userID), attempts, nil
+}
+
+func (t *TwoFactorImpl) DeleteChallenge(ctx context.Context, token string) error {
+	if err := t.rc.Del(ctx, t.formatChallengeKey(token)).Err(); err != nil {
+		return fmt.Errorf("error while deleting two factor challenge from cache: %w", err)
+	}
+
+	return nil
+}
+
+// UseCode marks time step of user code as used. It returns false if code of the step
+// is already used, so the same code can't be used twice.
+func (t *TwoFactorImpl) UseCode(ctx context.Context, userID uint, step int64, ttl time.Duration) (bool, error) {
+	used, err := t.rc.SetNX(ctx, t.formatCodeKey(userID, step), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("error while marking totp code as used: %w", err)
+	}
+
+	return used, nil
+}
+
+// DeleteForUser deletes challenges and used code steps of user.
+func (t *TwoFactorImpl) DeleteForUser(ctx context.Context, userID uint) error {
+	tokens, err := t.rc.SMembers(ctx, t.formatUserChallengesKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("error while getting user two factor challenges from cache: %w", err)
+	}
+
+	keys := []string{t.formatUserChallengesKey(userID)}
+	for _, token := range tokens {
+		keys = append(keys, t.formatChallengeKey(token))
+	}
+
+	iter := t.rc.Scan(ctx, 0, t.formatCodeKeyPattern(userID), twoFactorScanSize).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("error while scanning user used totp codes: %w", err)
+	}
+
+	if err := t.rc.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("error while deleting user two factor data from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (t *TwoFactorImpl) formatChallengeKey(token string) string {
+	return fmt.Sprintf("tf_ch_%s", token)
+}
+
+func (t *TwoFactorImpl) formatUserChallengesKey(userID uint) string {
+	return fmt.Sprintf("tf_ch_user_%d", userID)
+}
+
+func (t *TwoFactorImpl) formatCodeKey(userID uint, step int64) string {
+	return fmt.Sprintf("tf_code_%d_%d", userID, step)
+}
+
+func (t *TwoFactorImpl) formatCodeKeyPattern(userID uint) string {
+	return fmt.Sprintf("tf_code_%d_*", userID)
+}
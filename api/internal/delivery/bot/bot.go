@@ -0,0 +1,107 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/telegram"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+)
+
+// retryDelay is a delay before polling updates again after failed attempt.
+const retryDelay = 5 * time.Second
+
+// Bot is a Telegram bot which long polls updates and answers commands.
+type Bot struct {
+	client   *telegram.Client
+	services *services.Service
+	commands map[string]command
+	logger   *logging.Logger
+	wg       sync.WaitGroup
+}
+
+func New(client *telegram.Client, services *services.Service) *Bot {
+	b := &Bot{
+		client:   client,
+		services: services,
+		logger:   logging.NewLogger("telegram bot"),
+	}
+	b.commands = b.initCommands()
+
+	return b
+}
+
+// Run starts polling updates until context is canceled. It does nothing
+// when bot token isn't configured.
+func (b *Bot) Run(ctx context.Context) {
+	if !b.client.IsEnabled() {
+		logrus.Info("telegram bot token isn't configured, bot is disabled")
+
+		return
+	}
+
+	b.wg.Add(1)
+
+	go func() {
+		defer b.wg.Done()
+		b.poll(ctx)
+	}()
+}
+
+func (b *Bot) Wait() {
+	b.wg.Wait()
+}
+
+func (b *Bot) poll(ctx context.Context) {
+	offset := 0
+
+	for {
+		updates, err := b.client.GetUpdates(ctx, offset)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return
+			}
+
+			b.logger.Error(err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryDelay):
+				continue
+			}
+		}
+
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+
+			if update.Message != nil {
+				b.handleMessage(ctx, *update.Message)
+			}
+		}
+	}
+}
+
+// handleMessage answers command of message, every command is traced as span of its own.
+func (b *Bot) handleMessage(ctx context.Context, message telegram.Message) {
+	name, args := parseCommand(message.Text)
+
+	handler, ok := b.commands[name]
+	if !ok {
+		handler = b.help
+	}
+
+	ctx, span := tracing.Start(ctx, "bot.command", attribute.String("bot.command", name))
+	defer span.End()
+
+	if err := b.client.SendMessage(ctx, message.Chat.ID, handler(ctx, message.Chat.ID, args)); err != nil {
+		b.logger.Error(err)
+	}
+}
@@ -0,0 +1,218 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+const (
+	historyLimit = 10
+	timeFormat   = "02.01.2006 15:04"
+
+	helpMessage = `Бот присылает изменения вашего положения в рейтинговых списках.
+
+/link <код> — привязать аккаунт, код можно получить в личном кабинете
+/rating — рейтинг по отслеживаемым направлениям
+/directions — отслеживаемые направления
+/history <id направления> — история изменений рейтинга
+/unlink — отвязать аккаунт`
+	linkedMessage        = "Аккаунт привязан, теперь вы будете получать уведомления об изменениях рейтинга"
+	unlinkedMessage      = "Аккаунт отвязан, уведомления больше не будут приходить"
+	notLinkedMessage     = "Аккаунт не привязан. Получите код в личном кабинете и отправьте /link <код>"
+	invalidCodeMessage   = "Код недействителен или устарел"
+	noDirectionsMessage  = "Вы не отслеживаете ни одного направления"
+	snilsNotSetMessage   = "Укажите СНИЛС в личном кабинете, чтобы получать рейтинг"
+	noHistoryMessage     = "История изменений рейтинга пуста"
+	historyUsageMessage  = "Укажите id направления: /history <id>, id можно узнать командой /directions"
+	internalErrorMessage = "Что-то пошло не так, попробуйте позже"
+)
+
+// command handles command arguments sent from chat and returns answer.
+type command func(ctx context.Context, chatID int64, args []string) string
+
+func (b *Bot) initCommands() map[string]command {
+	return map[string]command{
+		"/start":      b.start,
+		"/help":       b.help,
+		"/link":       b.link,
+		"/unlink":     b.authorized(b.unlink),
+		"/rating":     b.authorized(b.rating),
+		"/directions": b.authorized(b.directions),
+		"/history":    b.authorized(b.history),
+	}
+}
+
+// parseCommand splits message text into command name and arguments.
+// Bot username suffix of command, e.g. /rating@rlmp_bot, is trimmed.
+func parseCommand(text string) (string, []string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	name := strings.ToLower(fields[0])
+	if i := strings.Index(name, "@"); i != -1 {
+		name = name[:i]
+	}
+
+	return name, fields[1:]
+}
+
+// authorized resolves user linked to chat and passes it to handler.
+func (b *Bot) authorized(handler func(ctx context.Context, userID uint, args []string) string) command {
+	return func(ctx context.Context, chatID int64, args []string) string {
+		userID, err := b.services.Telegram.GetUserIDByChatID(ctx, chatID)
+		if err != nil {
+			if errors.Is(err, services.TelegramNotLinkedError) {
+				return notLinkedMessage
+			}
+
+			b.logger.WithContext(ctx).Error(err)
+
+			return internalErrorMessage
+		}
+
+		return handler(logging.ContextWithUserID(ctx, userID), userID, args)
+	}
+}
+
+// start links account when bot is opened by deep link with code, e.g. t.me/rlmp_bot?start=CODE.
+func (b *Bot) start(ctx context.Context, chatID int64, args []string) string {
+	if len(args) != 0 {
+		return b.link(ctx, chatID, args)
+	}
+
+	return helpMessage
+}
+
+func (b *Bot) help(_ context.Context, _ int64, _ []string) string {
+	return helpMessage
+}
+
+func (b *Bot) link(ctx context.Context, chatID int64, args []string) string {
+	if len(args) == 0 {
+		return notLinkedMessage
+	}
+
+	if err := b.services.Telegram.Link(ctx, args[0], chatID); err != nil {
+		if errors.Is(err, services.InvalidConfirmationTokenError) {
+			return invalidCodeMessage
+		}
+
+		b.logger.WithContext(ctx).Error(err)
+
+		return internalErrorMessage
+	}
+
+	return linkedMessage
+}
+
+func (b *Bot) unlink(ctx context.Context, userID uint, _ []string) string {
+	if err := b.services.Telegram.Unlink(ctx, userID); err != nil {
+		b.logger.WithContext(ctx).Error(err)
+
+		return internalErrorMessage
+	}
+
+	return unlinkedMessage
+}
+
+func (b *Bot) rating(ctx context.Context, userID uint, _ []string) string {
+	universities, err := b.services.Direction.GetForUserWithRating(ctx, userID)
+	if errors.Is(err, services.SnilsNotSetError) {
+		return snilsNotSetMessage
+	}
+
+	if err != nil {
+		b.logger.WithContext(ctx).Error(err)
+
+		return internalErrorMessage
+	}
+
+	if len(universities) == 0 {
+		return noDirectionsMessage
+	}
+
+	var m strings.Builder
+
+	for _, u := range universities {
+		fmt.Fprintf(&m, "%s\n", u.UniversityName)
+
+		for _, d := range u.Directions {
+			fmt.Fprintf(
+				&m, "• %s\n  позиция %d, баллы %d, согласий выше %d, бюджетных мест %d\n",
+				d.Name, d.Position, d.Score, d.SubmittedConsentUpper, d.BudgetPlaces,
+			)
+		}
+
+		m.WriteString("\n")
+	}
+
+	return strings.TrimSpace(m.String())
+}
+
+func (b *Bot) directions(ctx context.Context, userID uint, _ []string) string {
+	universities, err := b.services.Direction.GetForUser(ctx, userID)
+	if err != nil {
+		b.logger.WithContext(ctx).Error(err)
+
+		return internalErrorMessage
+	}
+
+	if len(universities) == 0 {
+		return noDirectionsMessage
+	}
+
+	var m strings.Builder
+
+	for _, u := range universities {
+		fmt.Fprintf(&m, "%s\n", u.UniversityName)
+
+		for _, d := range u.Directions {
+			fmt.Fprintf(&m, "• [%d] %s\n", d.ID, d.Name)
+		}
+
+		m.WriteString("\n")
+	}
+
+	return strings.TrimSpace(m.String())
+}
+
+func (b *Bot) history(ctx context.Context, userID uint, args []string) string {
+	if len(args) == 0 {
+		return historyUsageMessage
+	}
+
+	directionID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return historyUsageMessage
+	}
+
+	records, err := b.services.RatingHistory.GetForDirection(ctx, userID, uint(directionID), historyLimit)
+	if err != nil {
+		b.logger.WithContext(ctx).Error(err)
+
+		return internalErrorMessage
+	}
+
+	if len(records) == 0 {
+		return noHistoryMessage
+	}
+
+	var m strings.Builder
+
+	for _, r := range records {
+		fmt.Fprintf(
+			&m, "%s — позиция %d, согласий выше %d\n",
+			r.CreatedAt.Local().Format(timeFormat), r.Position, r.SubmittedConsentUpper,
+		)
+	}
+
+	return strings.TrimSpace(m.String())
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+// Executor executes read-only operations over universities, directions and ratings of user
+// resolved by services. Service calls are batched per operation by loaders.
+type Executor struct {
+	schema   gql.Schema
+	services *services.Service
+	config   *config.GraphQL
+}
+
+func NewExecutor(services *services.Service, validate *validator.Validate) (*Executor, error) {
+	schema, err := newSchema(services, validate)
+	if err != nil {
+		return nil, fmt.Errorf("error while building graphql schema: %w", err)
+	}
+
+	return &Executor{
+		schema:   schema,
+		services: services,
+		config:   config.Get().GraphQL,
+	}, nil
+}
+
+// Execute parses and validates operation and checks its depth and complexity before execution,
+// operation errors are returned in result.
+func (e *Executor) Execute(ctx context.Context, userID uint, request dto.GraphQLRequest) *gql.Result {
+	document, err := parser.Parse(parser.ParseParams{Source: request.Query})
+	if err != nil {
+		return &gql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if validation := gql.ValidateDocument(&e.schema, document, nil); !validation.IsValid {
+		return &gql.Result{Errors: validation.Errors}
+	}
+
+	if err := checkLimits(
+		&e.schema, document, request.OperationName, request.Variables,
+		e.config.MaxDepth, e.config.MaxComplexity, e.config.DefaultListSize,
+	); err != nil {
+		return &gql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	return gql.Execute(gql.ExecuteParams{
+		Schema:        e.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       withRequest(ctx, userID, newLoaders(ctx, e.services, userID)),
+	})
+}
@@ -0,0 +1,182 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// limits checks depth and complexity of operation before execution. Every field costs one,
+// fields of list are counted as many times as list size, which is taken from first or limit
+// argument or assumed to be default list size. Size argument of page (not list) field is
+// applied to list inside page. Introspection fields aren't counted.
+type limits struct {
+	schema          *gql.Schema
+	fragments       map[string]*ast.FragmentDefinition
+	variables       map[string]interface{}
+	defaultListSize int
+}
+
+func checkLimits(
+	schema *gql.Schema,
+	document *ast.Document,
+	operationName string,
+	variables map[string]interface{},
+	maxDepth int,
+	maxComplexity int,
+	defaultListSize int,
+) error {
+	l := &limits{
+		schema:          schema,
+		fragments:       make(map[string]*ast.FragmentDefinition),
+		variables:       variables,
+		defaultListSize: defaultListSize,
+	}
+
+	var operation *ast.OperationDefinition
+
+	for _, definition := range document.Definitions {
+		switch d := definition.(type) {
+		case *ast.FragmentDefinition:
+			l.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				operation = d
+			}
+		}
+	}
+
+	if operation == nil {
+		return nil
+	}
+
+	complexity, depth := l.measure(operation.SelectionSet, schema.QueryType(), 1, 0)
+	if depth > maxDepth {
+		return fmt.Errorf("operation depth %d exceeds limit %d", depth, maxDepth)
+	}
+
+	if complexity > maxComplexity {
+		return fmt.Errorf("operation complexity %d exceeds limit %d", complexity, maxComplexity)
+	}
+
+	return nil
+}
+
+// measure returns complexity and depth of selection set of the given type,
+// page size is size of lists in selection set given by page field.
+func (l *limits) measure(selectionSet *ast.SelectionSet, parent gql.Type, depth int, pageSize int) (int, int) {
+	if selectionSet == nil {
+		return 0, depth - 1
+	}
+
+	complexity, maxDepth := 0, depth
+
+	for _, selection := range selectionSet.Selections {
+		var c, d int
+
+		switch s := selection.(type) {
+		case *ast.Field:
+			c, d = l.measureField(s, parent, depth, pageSize)
+		case *ast.InlineFragment:
+			t := parent
+			if s.TypeCondition != nil {
+				t = l.schema.Type(s.TypeCondition.Name.Value)
+			}
+
+			c, d = l.measure(s.SelectionSet, t, depth, pageSize)
+		case *ast.FragmentSpread:
+			fragment, ok := l.fragments[s.Name.Value]
+			if !ok {
+				continue
+			}
+
+			c, d = l.measure(fragment.SelectionSet, l.schema.Type(fragment.TypeCondition.Name.Value), depth, pageSize)
+		}
+
+		complexity += c
+		if d > maxDepth {
+			maxDepth = d
+		}
+	}
+
+	return complexity, maxDepth
+}
+
+func (l *limits) measureField(field *ast.Field, parent gql.Type, depth int, pageSize int) (int, int) {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return 0, depth
+	}
+
+	object, ok := parent.(*gql.Object)
+	if !ok {
+		return 1, depth
+	}
+
+	definition, ok := object.Fields()[field.Name.Value]
+	if !ok {
+		return 1, depth
+	}
+
+	t, isList := unwrapType(definition.Type)
+	size := l.sizeArgument(field)
+
+	if !isList {
+		complexity, maxDepth := l.measure(field.SelectionSet, t, depth+1, size)
+
+		return 1 + complexity, maxDepth
+	}
+
+	if size == 0 {
+		size = pageSize
+	}
+
+	if size == 0 {
+		size = l.defaultListSize
+	}
+
+	complexity, maxDepth := l.measure(field.SelectionSet, t, depth+1, 0)
+
+	return 1 + complexity*size, maxDepth
+}
+
+// sizeArgument returns value of first or limit argument of field, it is zero if there is no argument.
+func (l *limits) sizeArgument(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" && argument.Name.Value != "limit" {
+			continue
+		}
+
+		switch v := argument.Value.(type) {
+		case *ast.IntValue:
+			if size, err := strconv.Atoi(v.Value); err == nil && size > 0 {
+				return size
+			}
+		case *ast.Variable:
+			if size, ok := l.variables[v.Name.Value].(float64); ok && size > 0 {
+				return int(size)
+			}
+		}
+	}
+
+	return 0
+}
+
+// unwrapType returns named type of field and reports whether field is list.
+func unwrapType(t gql.Type) (gql.Type, bool) {
+	isList := false
+
+	for {
+		switch w := t.(type) {
+		case *gql.NonNull:
+			t = w.OfType
+		case *gql.List:
+			isList = true
+			t = w.OfType
+		default:
+			return t, isList
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/dataloader"
+)
+
+type contextKey int
+
+const (
+	userIDContextKey contextKey = iota
+	loadersContextKey
+)
+
+// loaders batch service calls of single request, e.g. universities of all directions
+// in response are loaded by one query. Batches are loaded in context of the request.
+type loaders struct {
+	ctx                  context.Context
+	services             *services.Service
+	userID               uint
+	universities         *dataloader.Loader
+	universityDirections *dataloader.Loader
+	mu                   sync.Mutex
+	history              map[int]*dataloader.Loader
+}
+
+func newLoaders(ctx context.Context, services *services.Service, userID uint) *loaders {
+	l := &loaders{
+		ctx:      ctx,
+		services: services,
+		userID:   userID,
+		history:  make(map[int]*dataloader.Loader),
+	}
+
+	l.universities = dataloader.New(l.loadUniversities)
+	l.universityDirections = dataloader.New(l.loadUniversityDirections)
+
+	return l
+}
+
+func (l *loaders) loadUniversities(ids []uint) (map[uint]interface{}, error) {
+	universities, err := l.services.University.GetByIDs(l.ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[uint]interface{}, len(universities))
+	for _, u := range universities {
+		values[uint(u.ID)] = u
+	}
+
+	return values, nil
+}
+
+func (l *loaders) loadUniversityDirections(universityIDs []uint) (map[uint]interface{}, error) {
+	directions, err := l.services.Direction.GetForUniversities(l.ctx, universityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[uint][]dto.UniversityDirection, len(universityIDs))
+	for _, d := range directions {
+		grouped[d.UniversityID] = append(grouped[d.UniversityID], d)
+	}
+
+	values := make(map[uint]interface{}, len(universityIDs))
+	for _, id := range universityIDs {
+		if _, ok := grouped[id]; !ok {
+			grouped[id] = make([]dto.UniversityDirection, 0)
+		}
+
+		values[id] = grouped[id]
+	}
+
+	return values, nil
+}
+
+// historyLoader returns loader of user rating history of directions, history
+// requested with different limits is loaded separately.
+func (l *loaders) historyLoader(limit int) *dataloader.Loader {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if loader, ok := l.history[limit]; ok {
+		return loader
+	}
+
+	loader := dataloader.New(func(directionIDs []uint) (map[uint]interface{}, error) {
+		history, err := l.services.RatingHistory.GetForDirections(l.ctx, l.userID, directionIDs, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[uint]interface{}, len(directionIDs))
+		for _, id := range directionIDs {
+			if _, ok := history[id]; !ok {
+				history[id] = make([]dto.RatingHistoryRecord, 0)
+			}
+
+			values[id] = history[id]
+		}
+
+		return values, nil
+	})
+	l.history[limit] = loader
+
+	return loader
+}
+
+func withRequest(ctx context.Context, userID uint, l *loaders) context.Context {
+	return context.WithValue(context.WithValue(ctx, userIDContextKey, userID), loadersContextKey, l)
+}
+
+func getUserID(ctx context.Context) uint {
+	userID, _ := ctx.Value(userIDContextKey).(uint)
+
+	return userID
+}
+
+func getLoaders(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersContextKey).(*loaders)
+
+	return l
+}
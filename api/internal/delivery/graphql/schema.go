@@ -0,0 +1,380 @@
+package graphql
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	gql "github.com/graphql-go/graphql"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+)
+
+const (
+	defaultHistoryLimit = 10
+	maxHistoryLimit     = 100
+)
+
+var errInvalidHistoryLimit = errors.New("history limit must be between 1 and 100")
+
+type user struct {
+	id      uint
+	profile *dto.UserProfile
+}
+
+type ratingResult struct {
+	direction dto.UniversityDirection
+	rating    dto.RatingState
+}
+
+// schemaBuilder builds types of schema, they refer to each other, so their fields are built lazily.
+type schemaBuilder struct {
+	services       *services.Service
+	validate       *validator.Validate
+	user           *gql.Object
+	university     *gql.Object
+	direction      *gql.Object
+	directionsPage *gql.Object
+	ratingResult   *gql.Object
+	history        *gql.Object
+}
+
+func newSchema(services *services.Service, validate *validator.Validate) (gql.Schema, error) {
+	b := &schemaBuilder{
+		services: services,
+		validate: validate,
+	}
+
+	b.university = gql.NewObject(gql.ObjectConfig{Name: "University", Fields: gql.FieldsThunk(b.universityFields)})
+	b.direction = gql.NewObject(gql.ObjectConfig{Name: "Direction", Fields: gql.FieldsThunk(b.directionFields)})
+	b.history = gql.NewObject(gql.ObjectConfig{Name: "History", Fields: b.historyFields()})
+	b.ratingResult = gql.NewObject(gql.ObjectConfig{Name: "RatingResult", Fields: b.ratingResultFields()})
+	b.directionsPage = gql.NewObject(gql.ObjectConfig{Name: "DirectionsPage", Fields: b.directionsPageFields()})
+	b.user = gql.NewObject(gql.ObjectConfig{Name: "User", Fields: b.userFields()})
+
+	return gql.NewSchema(gql.SchemaConfig{
+		Query: gql.NewObject(gql.ObjectConfig{Name: "Query", Fields: b.queryFields()}),
+	})
+}
+
+func (b *schemaBuilder) queryFields() gql.Fields {
+	return gql.Fields{
+		"me": &gql.Field{
+			Type: gql.NewNonNull(b.user),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				userID := getUserID(p.Context)
+
+				profile, err := b.services.User.GetProfile(p.Context, userID)
+				if err != nil {
+					return nil, err
+				}
+
+				return user{id: userID, profile: profile}, nil
+			},
+		},
+		"universities": &gql.Field{
+			Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(b.university))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return b.services.University.GetAll(p.Context)
+			},
+		},
+		"university": &gql.Field{
+			Type: b.university,
+			Args: gql.FieldConfigArgument{
+				"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+			},
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return getLoaders(p.Context).universities.Load(uint(p.Args["id"].(int))), nil
+			},
+		},
+		"direction": &gql.Field{
+			Type: b.direction,
+			Args: gql.FieldConfigArgument{
+				"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+			},
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				direction, err := b.services.Direction.GetByID(p.Context, uint(p.Args["id"].(int)))
+				if errors.Is(err, services.DirectionNotFoundError) {
+					return nil, nil
+				} else if err != nil {
+					return nil, err
+				}
+
+				return dto.UniversityDirection{
+					ID:           direction.ID,
+					Name:         direction.Name,
+					UniversityID: direction.UniversityID,
+				}, nil
+			},
+		},
+		"directions": &gql.Field{
+			Type:        gql.NewNonNull(b.directionsPage),
+			Description: "Directions catalogue ordered by id, page continues after cursor.",
+			Args: gql.FieldConfigArgument{
+				"universityId": &gql.ArgumentConfig{Type: gql.Int},
+				"search":       &gql.ArgumentConfig{Type: gql.String},
+				"code":         &gql.ArgumentConfig{Type: gql.String},
+				"first":        &gql.ArgumentConfig{Type: gql.Int},
+				"after":        &gql.ArgumentConfig{Type: gql.String},
+			},
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				var query dto.DirectionQuery
+
+				if universityID, ok := p.Args["universityId"].(int); ok {
+					id := uint(universityID)
+					query.UniversityID = &id
+				}
+
+				query.Search, _ = p.Args["search"].(string)
+				query.Code, _ = p.Args["code"].(string)
+				query.Limit, _ = p.Args["first"].(int)
+				query.Cursor, _ = p.Args["after"].(string)
+
+				if err := query.Validate(b.validate); err != nil {
+					return nil, err
+				}
+
+				return b.services.Direction.Find(p.Context, query)
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) userFields() gql.Fields {
+	profileField := func(get func(profile *dto.UserProfile) interface{}) gql.FieldResolveFn {
+		return func(p gql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(user).profile), nil
+		}
+	}
+
+	return gql.Fields{
+		"id": &gql.Field{
+			Type: gql.NewNonNull(gql.Int),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(user).id, nil
+			},
+		},
+		"username": &gql.Field{
+			Type:    gql.NewNonNull(gql.String),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.Username }),
+		},
+		"firstName": &gql.Field{
+			Type:    gql.NewNonNull(gql.String),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.FirstName }),
+		},
+		"middleName": &gql.Field{
+			Type:    gql.NewNonNull(gql.String),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.MiddleName }),
+		},
+		"lastName": &gql.Field{
+			Type:    gql.NewNonNull(gql.String),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.LastName }),
+		},
+		"snils": &gql.Field{
+			Type:    gql.NewNonNull(gql.String),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.Snils }),
+		},
+		"email": &gql.Field{
+			Type:    gql.NewNonNull(gql.String),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.Email }),
+		},
+		"isEmailVerified": &gql.Field{
+			Type:    gql.NewNonNull(gql.Boolean),
+			Resolve: profileField(func(profile *dto.UserProfile) interface{} { return profile.IsEmailVerified }),
+		},
+		"universities": &gql.Field{
+			Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(b.university))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return b.services.University.GetForUser(p.Context, p.Source.(user).id)
+			},
+		},
+		"directions": &gql.Field{
+			Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(b.direction))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return b.services.Direction.GetTrackedForUser(p.Context, p.Source.(user).id)
+			},
+		},
+		"ratings": &gql.Field{
+			Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(b.ratingResult))),
+			Description: "Current user rating in lists of tracked directions, lists are parsed if they aren't cached.",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				universityDirections, err := b.services.Direction.GetForUserWithRating(p.Context, p.Source.(user).id)
+				if err != nil {
+					return nil, err
+				}
+
+				return mapRatingResults(universityDirections), nil
+			},
+		},
+	}
+}
+
+func mapRatingResults(universityDirections []dto.UniversityDirectionsWithRating) []ratingResult {
+	results := make([]ratingResult, 0)
+
+	for _, ud := range universityDirections {
+		for _, d := range ud.Directions {
+			results = append(results, ratingResult{
+				direction: dto.UniversityDirection{
+					ID:                 d.ID,
+					Name:               d.Name,
+					UniversityID:       ud.UniversityID,
+					UniversityName:     ud.UniversityName,
+					UniversityFullName: ud.UniversityFullName,
+				},
+				rating: dto.NewRatingState(dto.ParsingResult{
+					Position:              d.Position,
+					Score:                 d.Score,
+					PriorityOneUpper:      d.PriorityOneUpper,
+					SubmittedConsentUpper: d.SubmittedConsentUpper,
+					BudgetPlaces:          d.BudgetPlaces,
+				}),
+			})
+		}
+	}
+
+	return results
+}
+
+func (b *schemaBuilder) universityFields() gql.Fields {
+	return gql.Fields{
+		"id": &gql.Field{
+			Type: gql.NewNonNull(gql.Int),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(dto.University).ID, nil
+			},
+		},
+		"name": &gql.Field{
+			Type: gql.NewNonNull(gql.String),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(dto.University).Name, nil
+			},
+		},
+		"fullName": &gql.Field{
+			Type: gql.NewNonNull(gql.String),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(dto.University).FullName, nil
+			},
+		},
+		"directions": &gql.Field{
+			Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(b.direction))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return getLoaders(p.Context).universityDirections.Load(uint(p.Source.(dto.University).ID)), nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) directionFields() gql.Fields {
+	return gql.Fields{
+		"id": &gql.Field{
+			Type: gql.NewNonNull(gql.Int),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(dto.UniversityDirection).ID, nil
+			},
+		},
+		"name": &gql.Field{
+			Type: gql.NewNonNull(gql.String),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(dto.UniversityDirection).Name, nil
+			},
+		},
+		"university": &gql.Field{
+			Type: gql.NewNonNull(b.university),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return getLoaders(p.Context).universities.Load(p.Source.(dto.UniversityDirection).UniversityID), nil
+			},
+		},
+		"history": &gql.Field{
+			Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(b.history))),
+			Description: "The latest states of user rating in direction list, the latest go first.",
+			Args: gql.FieldConfigArgument{
+				"limit": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: defaultHistoryLimit},
+			},
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				limit, _ := p.Args["limit"].(int)
+				if limit < 1 || limit > maxHistoryLimit {
+					return nil, errInvalidHistoryLimit
+				}
+
+				return getLoaders(p.Context).historyLoader(limit).Load(p.Source.(dto.UniversityDirection).ID), nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) directionsPageFields() gql.Fields {
+	return gql.Fields{
+		"directions": &gql.Field{
+			Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(b.direction))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return p.Source.(*dto.DirectionsPage).Directions, nil
+			},
+		},
+		"nextCursor": &gql.Field{
+			Type:        gql.String,
+			Description: "Cursor of the next page, it is null on the last page.",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				if cursor := p.Source.(*dto.DirectionsPage).NextCursor; cursor != "" {
+					return cursor, nil
+				}
+
+				return nil, nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) ratingResultFields() gql.Fields {
+	fields := ratingStateFields(func(source interface{}) dto.RatingState {
+		return source.(ratingResult).rating
+	})
+
+	fields["direction"] = &gql.Field{
+		Type: gql.NewNonNull(b.direction),
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			return p.Source.(ratingResult).direction, nil
+		},
+	}
+
+	return fields
+}
+
+func (b *schemaBuilder) historyFields() gql.Fields {
+	fields := ratingStateFields(func(source interface{}) dto.RatingState {
+		return source.(dto.RatingHistoryRecord).RatingState
+	})
+
+	fields["createdAt"] = &gql.Field{
+		Type: gql.NewNonNull(gql.DateTime),
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			return p.Source.(dto.RatingHistoryRecord).CreatedAt, nil
+		},
+	}
+
+	return fields
+}
+
+func ratingStateFields(get func(source interface{}) dto.RatingState) gql.Fields {
+	field := func(t gql.Output, value func(state dto.RatingState) interface{}) *gql.Field {
+		return &gql.Field{
+			Type: gql.NewNonNull(t),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				return value(get(p.Source)), nil
+			},
+		}
+	}
+
+	return gql.Fields{
+		"position": field(gql.Int, func(s dto.RatingState) interface{} { return s.Position }),
+		"score":    field(gql.Int, func(s dto.RatingState) interface{} { return s.Score }),
+		"priorityOneUpper": field(gql.Int, func(s dto.RatingState) interface{} {
+			return s.PriorityOneUpper
+		}),
+		"submittedConsentUpper": field(gql.Int, func(s dto.RatingState) interface{} {
+			return s.SubmittedConsentUpper
+		}),
+		"budgetPlaces":   field(gql.Int, func(s dto.RatingState) interface{} { return s.BudgetPlaces }),
+		"isWithinBudget": field(gql.Boolean, func(s dto.RatingState) interface{} { return s.IsWithinBudget }),
+	}
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/rlmppb"
+)
+
+type AuthorizationImpl struct {
+	rlmppb.UnimplementedAuthorizationServer
+	validate             *validator.Validate
+	authorizationService services.Authorization
+	logger               *logging.Logger
+}
+
+func NewAuthorizationImpl(
+	validate *validator.Validate,
+	authorizationService services.Authorization,
+) *AuthorizationImpl {
+	return &AuthorizationImpl{
+		validate:             validate,
+		authorizationService: authorizationService,
+		logger:               logging.NewLogger("grpc authorization"),
+	}
+}
+
+func (a *AuthorizationImpl) SignIn(
+	ctx context.Context,
+	req *rlmppb.SignInRequest,
+) (*rlmppb.SignInResponse, error) {
+	payload := dto.UserCredentials{Username: req.GetUsername(), Password: req.GetPassword()}
+	if err := payload.Validate(a.validate); err != nil {
+		a.logger.Error(err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := a.authorizationService.GenerateTokens(ctx, payload, getClientInfo(ctx))
+	if err != nil {
+		a.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	if result.Challenge != nil {
+		return &rlmppb.SignInResponse{Result: &rlmppb.SignInResponse_Challenge{
+			Challenge: &rlmppb.TwoFactorChallenge{
+				ChallengeToken: result.Challenge.ChallengeToken,
+				ExpiresIn:      int32(result.Challenge.ExpiresIn),
+			},
+		}}, nil
+	}
+
+	return &rlmppb.SignInResponse{Result: &rlmppb.SignInResponse_Tokens{Tokens: newTokens(result.Tokens)}}, nil
+}
+
+func (a *AuthorizationImpl) SignInTwoFactor(
+	ctx context.Context,
+	req *rlmppb.SignInTwoFactorRequest,
+) (*rlmppb.Tokens, error) {
+	payload := dto.TwoFactorSigningIn{ChallengeToken: req.GetChallengeToken(), Code: req.GetCode()}
+	if err := payload.Validate(a.validate); err != nil {
+		a.logger.Error(err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := a.authorizationService.SignInTwoFactor(ctx, payload, getClientInfo(ctx))
+	if err != nil {
+		a.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	return newTokens(tokens), nil
+}
+
+func (a *AuthorizationImpl) RefreshTokens(
+	ctx context.Context,
+	req *rlmppb.RefreshTokensRequest,
+) (*rlmppb.Tokens, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, statusError(services.InvalidTokenError)
+	}
+
+	tokens, err := a.authorizationService.RefreshTokens(ctx, req.GetRefreshToken(), getClientInfo(ctx))
+	if err != nil {
+		a.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	return newTokens(tokens), nil
+}
+
+func newTokens(tokens *dto.AuthorizationTokens) *rlmppb.Tokens {
+	return &rlmppb.Tokens{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+const userAgentMetadata = "user-agent"
+
+// requestIDInterceptor sets id of call the same way as http middleware does, request id
+// of client is taken from x-request-id metadata and returned in header.
+func requestIDInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	requestID := middleware.ResolveRequestID(getMetadata(ctx, middleware.RequestIDHeader))
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(middleware.RequestIDHeader, requestID))
+
+	return handler(logging.ContextWithRequestID(ctx, requestID), req)
+}
+
+func getClientInfo(ctx context.Context) dto.ClientInfo {
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+
+	return dto.ClientInfo{
+		IP:        ip,
+		UserAgent: getMetadata(ctx, userAgentMetadata),
+		RequestID: logging.RequestIDFromContext(ctx),
+	}
+}
+
+func getMetadata(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+var callLogger = logging.NewLogger("grpc")
+
+// loggingInterceptor writes entry of every call with its status code and duration
+// the same way as http middleware writes entries of requests.
+func loggingInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	callLogger.WithContext(ctx).WithFields(logging.Fields{
+		"method":      info.FullMethod,
+		"code":        status.Code(err).String(),
+		"duration_ms": time.Since(start).Milliseconds(),
+		"user_agent":  getMetadata(ctx, userAgentMetadata),
+	}).Infof("%s", info.FullMethod)
+
+	return resp, err
+}
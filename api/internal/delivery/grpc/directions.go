@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/rlmppb"
+)
+
+type DirectionsImpl struct {
+	rlmppb.UnimplementedDirectionsServer
+	validate         *validator.Validate
+	directionService services.Direction
+	logger           *logging.Logger
+}
+
+func NewDirectionsImpl(validate *validator.Validate, directionService services.Direction) *DirectionsImpl {
+	return &DirectionsImpl{
+		validate:         validate,
+		directionService: directionService,
+		logger:           logging.NewLogger("grpc directions"),
+	}
+}
+
+func (d *DirectionsImpl) ListDirections(
+	ctx context.Context,
+	req *rlmppb.ListDirectionsRequest,
+) (*rlmppb.ListDirectionsResponse, error) {
+	query := dto.DirectionQuery{
+		Search: req.GetSearch(),
+		Code:   req.GetCode(),
+		Cursor: req.GetCursor(),
+		Limit:  int(req.GetLimit()),
+	}
+
+	if req.GetUniversityId() != 0 {
+		universityID := uint(req.GetUniversityId())
+		query.UniversityID = &universityID
+	}
+
+	if err := query.Validate(d.validate); err != nil {
+		d.logger.Error(err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := d.directionService.Find(ctx, query)
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	return &rlmppb.ListDirectionsResponse{
+		Directions: newDirections(page.Directions),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (d *DirectionsImpl) ListUserDirections(
+	ctx context.Context,
+	_ *rlmppb.ListUserDirectionsRequest,
+) (*rlmppb.ListUserDirectionsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, err
+	}
+
+	directions, err := d.directionService.GetTrackedForUser(ctx, userID)
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	return &rlmppb.ListUserDirectionsResponse{Directions: newDirections(directions)}, nil
+}
+
+func (d *DirectionsImpl) AddUserDirection(
+	ctx context.Context,
+	req *rlmppb.AddUserDirectionRequest,
+) (*rlmppb.AddUserDirectionResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, err
+	}
+
+	added, err := d.directionService.AddForUser(ctx, userID, uint(req.GetDirectionId()), getClientInfo(ctx))
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	direction, err := d.directionService.GetTrackedForUserByID(ctx, userID, uint(req.GetDirectionId()))
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	return &rlmppb.AddUserDirectionResponse{Direction: newDirection(*direction), Added: added}, nil
+}
+
+func (d *DirectionsImpl) RemoveUserDirection(
+	ctx context.Context,
+	req *rlmppb.RemoveUserDirectionRequest,
+) (*rlmppb.RemoveUserDirectionResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		d.logger.Error(err)
+
+		return nil, err
+	}
+
+	if err := d.directionService.RemoveForUser(ctx,
+		userID, uint(req.GetDirectionId()), getClientInfo(ctx),
+	); err != nil {
+		d.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	return &rlmppb.RemoveUserDirectionResponse{}, nil
+}
+
+func newDirection(d dto.UniversityDirection) *rlmppb.Direction {
+	return &rlmppb.Direction{
+		Id:                 uint64(d.ID),
+		Name:               d.Name,
+		UniversityId:       uint64(d.UniversityID),
+		UniversityName:     d.UniversityName,
+		UniversityFullName: d.UniversityFullName,
+	}
+}
+
+func newDirections(directions []dto.UniversityDirection) []*rlmppb.Direction {
+	result := make([]*rlmppb.Direction, len(directions))
+	for i, d := range directions {
+		result[i] = newDirection(d)
+	}
+
+	return result
+}
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+)
+
+var kindCodes = map[apierrors.Kind]codes.Code{
+	apierrors.KindValidation:          codes.InvalidArgument,
+	apierrors.KindUnauthorized:        codes.Unauthenticated,
+	apierrors.KindForbidden:           codes.PermissionDenied,
+	apierrors.KindNotFound:            codes.NotFound,
+	apierrors.KindConflict:            codes.AlreadyExists,
+	apierrors.KindLocked:              codes.ResourceExhausted,
+	apierrors.KindRateLimited:         codes.ResourceExhausted,
+	apierrors.KindUpstreamUnavailable: codes.Unavailable,
+	apierrors.KindInternal:            codes.Internal,
+}
+
+// statusError converts service error to status error with code matching kind of error,
+// messages of internal errors aren't exposed like in responses of http api.
+func statusError(err error) error {
+	apiErr := apierrors.From(err)
+
+	return status.Error(errorCode(apiErr.Kind), apiErr.Message)
+}
+
+func errorCode(kind apierrors.Kind) codes.Code {
+	if code, ok := kindCodes[kind]; ok {
+		return code
+	}
+
+	return codes.Internal
+}
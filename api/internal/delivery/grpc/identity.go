@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/rlmppb"
+)
+
+const (
+	authorizationMetadata = "authorization"
+	bearerPrefix          = "Bearer "
+)
+
+// MethodScopes are scopes required from personal access tokens by methods, personal
+// access tokens aren't accepted by methods which aren't listed.
+type MethodScopes map[string]string
+
+// identity authenticates calls by jwt access token or personal access token passed
+// by authorization metadata. Methods of public services are called without it.
+type identity struct {
+	services *services.Service
+	public   map[string]bool
+	scopes   MethodScopes
+	logger   *logging.Logger
+}
+
+func newIdentity(services *services.Service, scopes MethodScopes) *identity {
+	return &identity{
+		services: services,
+		public:   map[string]bool{rlmppb.Authorization_ServiceDesc.ServiceName: true},
+		scopes:   scopes,
+		logger:   logging.NewLogger("grpc identity"),
+	}
+}
+
+func (i *identity) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if i.public[serviceName(info.FullMethod)] {
+		return handler(ctx, req)
+	}
+
+	accessToken, err := getAccessToken(ctx)
+	if err != nil {
+		i.logger.WithContext(ctx).Error(err)
+
+		return nil, statusError(err)
+	}
+
+	userID, err := i.identify(ctx, accessToken, info.FullMethod)
+	if err != nil {
+		i.logger.WithContext(ctx).Error(err)
+
+		return nil, err
+	}
+
+	return handler(logging.ContextWithUserID(ctx, userID), req)
+}
+
+func (i *identity) identify(ctx context.Context, accessToken string, method string) (uint, error) {
+	if authorization.IsPersonalAccessToken(accessToken) {
+		return i.identifyByPersonalAccessToken(ctx, accessToken, method)
+	}
+
+	tokenClaims, err := i.services.Authorization.ParseAccessToken(accessToken)
+	if err != nil || tokenClaims.Id == "" {
+		return 0, statusError(apierrors.InvalidAuthorizationHeader)
+	}
+
+	revoked, err := i.services.Authorization.IsTokenRevoked(ctx, tokenClaims.Id)
+	if err != nil {
+		return 0, statusError(err)
+	}
+
+	if revoked {
+		return 0, statusError(apierrors.RevokedAccessToken)
+	}
+
+	return tokenClaims.UserID, nil
+}
+
+// identifyByPersonalAccessToken identifies user by personal access token, it is accepted
+// only by methods with required scope.
+func (i *identity) identifyByPersonalAccessToken(
+	ctx context.Context, token string, method string,
+) (uint, error) {
+	scope, ok := i.scopes[method]
+	if !ok {
+		return 0, statusError(apierrors.PersonalTokenNotAllowed)
+	}
+
+	claims, err := i.services.PersonalAccessToken.AuthenticatePersonalAccessToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, authorization.ErrPersonalAccessTokenRateLimitExceeded) {
+			return 0, statusError(apierrors.PersonalTokenRateLimitExceeded.Wrap(err))
+		}
+
+		if apierrors.From(err).Kind != apierrors.KindUnauthorized {
+			return 0, statusError(err)
+		}
+
+		return 0, statusError(apierrors.InvalidAuthorizationHeader)
+	}
+
+	if !claims.HasScope(scope) {
+		return 0, statusError(apierrors.InsufficientScope)
+	}
+
+	return claims.UserID, nil
+}
+
+func getAccessToken(ctx context.Context) (string, error) {
+	header := getMetadata(ctx, authorizationMetadata)
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", apierrors.InvalidAuthorizationHeader
+	}
+
+	accessToken := strings.TrimPrefix(header, bearerPrefix)
+	if accessToken == "" {
+		return "", apierrors.InvalidAuthorizationHeader
+	}
+
+	return accessToken, nil
+}
+
+// getUserID returns id of user identified by interceptor.
+func getUserID(ctx context.Context) (uint, error) {
+	userID, ok := logging.UserIDFromContext(ctx)
+	if !ok {
+		return 0, statusError(apierrors.Unidentified)
+	}
+
+	return userID, nil
+}
+
+// serviceName returns service of full method name, e.g. rlmp.v1.Ratings of /rlmp.v1.Ratings/GetRatings.
+func serviceName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i]
+	}
+
+	return name
+}
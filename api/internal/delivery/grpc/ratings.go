@@ -0,0 +1,138 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/rlmppb"
+)
+
+const (
+	defaultHistoryLimit = 10
+	maxHistoryLimit     = 100
+)
+
+var errInvalidHistoryLimit = errors.New("history limit must be between 0 and 100")
+
+type RatingsImpl struct {
+	rlmppb.UnimplementedRatingsServer
+	directionService     services.Direction
+	ratingHistoryService services.RatingHistory
+	logger               *logging.Logger
+}
+
+func NewRatingsImpl(
+	directionService services.Direction,
+	ratingHistoryService services.RatingHistory,
+) *RatingsImpl {
+	return &RatingsImpl{
+		directionService:     directionService,
+		ratingHistoryService: ratingHistoryService,
+		logger:               logging.NewLogger("grpc ratings"),
+	}
+}
+
+func (r *RatingsImpl) GetRatings(
+	ctx context.Context,
+	_ *rlmppb.GetRatingsRequest,
+) (*rlmppb.GetRatingsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		r.logger.Error(err)
+
+		return nil, err
+	}
+
+	universities, err := r.directionService.GetForUserWithRating(ctx, userID)
+	if err != nil {
+		r.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	response := &rlmppb.GetRatingsResponse{Universities: make([]*rlmppb.UniversityRatings, len(universities))}
+	for i, u := range universities {
+		directions := make([]*rlmppb.DirectionRating, len(u.Directions))
+		for j, d := range u.Directions {
+			directions[j] = &rlmppb.DirectionRating{
+				DirectionId:   uint64(d.ID),
+				DirectionName: d.Name,
+				Rating: newRating(dto.NewRatingState(dto.ParsingResult{
+					Position:              d.Position,
+					Score:                 d.Score,
+					PriorityOneUpper:      d.PriorityOneUpper,
+					SubmittedConsentUpper: d.SubmittedConsentUpper,
+					BudgetPlaces:          d.BudgetPlaces,
+				})),
+			}
+		}
+
+		response.Universities[i] = &rlmppb.UniversityRatings{
+			UniversityId:       uint64(u.UniversityID),
+			UniversityName:     u.UniversityName,
+			UniversityFullName: u.UniversityFullName,
+			Directions:         directions,
+		}
+	}
+
+	return response, nil
+}
+
+// GetRatingHistory returns the latest records of direction, zero limit means default limit.
+func (r *RatingsImpl) GetRatingHistory(
+	ctx context.Context,
+	req *rlmppb.GetRatingHistoryRequest,
+) (*rlmppb.GetRatingHistoryResponse, error) {
+	limit := int(req.GetLimit())
+	if limit < 0 || limit > maxHistoryLimit {
+		r.logger.Error(errInvalidHistoryLimit)
+
+		return nil, status.Error(codes.InvalidArgument, errInvalidHistoryLimit.Error())
+	}
+
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		r.logger.Error(err)
+
+		return nil, err
+	}
+
+	records, err := r.ratingHistoryService.GetForDirection(ctx, userID, uint(req.GetDirectionId()), limit)
+	if err != nil {
+		r.logger.Error(err)
+
+		return nil, statusError(err)
+	}
+
+	response := &rlmppb.GetRatingHistoryResponse{Records: make([]*rlmppb.RatingHistoryRecord, len(records))}
+	for i, record := range records {
+		response.Records[i] = &rlmppb.RatingHistoryRecord{
+			Rating:    newRating(record.RatingState),
+			CreatedAt: timestamppb.New(record.CreatedAt),
+		}
+	}
+
+	return response, nil
+}
+
+func newRating(r dto.RatingState) *rlmppb.Rating {
+	return &rlmppb.Rating{
+		Position:              uint32(r.Position),
+		Score:                 uint32(r.Score),
+		PriorityOneUpper:      uint32(r.PriorityOneUpper),
+		SubmittedConsentUpper: uint32(r.SubmittedConsentUpper),
+		BudgetPlaces:          uint32(r.BudgetPlaces),
+		IsWithinBudget:        r.IsWithinBudget,
+	}
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/rlmppb"
+)
+
+// Server serves authorization, directions and ratings for internal consumers on its own port.
+type Server struct {
+	grpcServer *grpc.Server
+	port       string
+}
+
+func NewServer(cfg *config.GRPC, services *services.Service, validate *validator.Validate) *Server {
+	identity := newIdentity(services, personalAccessTokenScopes())
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(),
+		requestIDInterceptor,
+		loggingInterceptor,
+		identity.UnaryInterceptor,
+	))
+	rlmppb.RegisterAuthorizationServer(grpcServer, NewAuthorizationImpl(validate, services.Authorization))
+	rlmppb.RegisterDirectionsServer(grpcServer, NewDirectionsImpl(validate, services.Direction))
+	rlmppb.RegisterRatingsServer(grpcServer, NewRatingsImpl(services.Direction, services.RatingHistory))
+
+	if cfg.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	return &Server{
+		grpcServer: grpcServer,
+		port:       cfg.Port,
+	}
+}
+
+func (s *Server) Run() error {
+	listener, err := net.Listen("tcp", ":"+s.port)
+	if err != nil {
+		return fmt.Errorf("error while listening grpc port: %w", err)
+	}
+
+	if err := s.grpcServer.Serve(listener); err != nil {
+		return fmt.Errorf("error while starting grpc server: %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown waits for pending calls to finish, calls are canceled when context is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+
+		return fmt.Errorf("error while shutdowning grpc server: %w", ctx.Err())
+	}
+}
+
+// personalAccessTokenScopes returns scopes required from personal access tokens by methods,
+// they are the same as scopes of matching http routes.
+func personalAccessTokenScopes() MethodScopes {
+	return MethodScopes{
+		fullMethod(rlmppb.Directions_ServiceDesc, "ListDirections"):      authorization.ScopeReadDirections,
+		fullMethod(rlmppb.Directions_ServiceDesc, "ListUserDirections"):  authorization.ScopeReadDirections,
+		fullMethod(rlmppb.Directions_ServiceDesc, "AddUserDirection"):    authorization.ScopeWriteDirections,
+		fullMethod(rlmppb.Directions_ServiceDesc, "RemoveUserDirection"): authorization.ScopeWriteDirections,
+		fullMethod(rlmppb.Ratings_ServiceDesc, "GetRatings"):             authorization.ScopeReadRatings,
+		fullMethod(rlmppb.Ratings_ServiceDesc, "GetRatingHistory"):       authorization.ScopeReadRatings,
+	}
+}
+
+func fullMethod(service grpc.ServiceDesc, method string) string {
+	return fmt.Sprintf("/%s/%s", service.ServiceName, method)
+}
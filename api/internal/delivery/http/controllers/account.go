@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+const (
+	exportFormatQueryParam = "format"
+	exportFormatZIP        = "zip"
+	exportFileName         = "rlmp-export"
+	zipContentType         = "application/zip"
+)
+
+type AccountImpl struct {
+	accountService services.Account
+}
+
+func NewAccountImpl(accountService services.Account) *AccountImpl {
+	return &AccountImpl{
+		accountService: accountService,
+	}
+}
+
+// Export
+// @tags user
+// @summary exports user personal data
+// @description returns profile with full SNILS, tracked directions, rating history, notification settings
+// @description and audit log of user, format=zip returns zip archive with json file per section
+// @produce json
+// @produce application/zip
+// @security AccessTokenHeader
+// @param format query string false "json (default) or zip"
+// @success 200 {object} dto.UserExport
+// @failure 401 {object} apierrors.Problem
+// @router /user/export [get].
+func (a *AccountImpl) Export(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	export, err := a.accountService.Export(c.Request.Context(), userID, getClientInfo(c))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if c.Query(exportFormatQueryParam) != exportFormatZIP {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.json", exportFileName))
+		c.JSON(http.StatusOK, export)
+
+		return
+	}
+
+	archive, err := newExportArchive(export)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", exportFileName))
+	c.Data(http.StatusOK, zipContentType, archive)
+}
+
+// Delete
+// @tags user
+// @summary deletes user account
+// @description deletes user with all personal data and revokes user sessions, it can't be undone
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 "success"
+// @failure 401 {object} apierrors.Problem
+// @router /user [delete].
+func (a *AccountImpl) Delete(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.accountService.Delete(c.Request.Context(), userID, getClientInfo(c)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// newExportArchive returns zip archive with json file per export section.
+func newExportArchive(export *dto.UserExport) ([]byte, error) {
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", export.Profile},
+		{"universities.json", export.Universities},
+		{"directions.json", export.Directions},
+		{"rating_history.json", export.RatingHistory},
+		{"alert_rules.json", export.AlertRules},
+		{"webhooks.json", export.Webhooks},
+		{"identities.json", export.Identities},
+		{"sessions.json", export.Sessions},
+		{"audit_log.json", export.AuditLog},
+	}
+
+	var buffer bytes.Buffer
+
+	archive := zip.NewWriter(&buffer)
+
+	for _, file := range files {
+		writer, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: export.ExportedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while creating export archive file: %w", err)
+		}
+
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(file.data); err != nil {
+			return nil, fmt.Errorf("error while writing export archive file: %w", err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("error while closing export archive: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type AdminImpl struct {
+	validate     *validator.Validate
+	adminService services.Admin
+}
+
+func NewAdminImpl(validate *validator.Validate, adminService services.Admin) *AdminImpl {
+	return &AdminImpl{
+		validate:     validate,
+		adminService: adminService,
+	}
+}
+
+// SetRole
+// @tags admin
+// @summary sets user role
+// @description sets role of user and revokes user sessions, requires admin role
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "user id"
+// @param payload body dto.RoleSetting true "role"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /admin/user/{id}/role [put].
+func (a *AdminImpl) SetRole(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	var payload dto.RoleSetting
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	adminID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.adminService.SetRole(c.Request.Context(), adminID, uint(id), payload, getClientInfo(c)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// PatchUniversity
+// @tags admin
+// @summary patches university
+// @description updates passed university fields, requires admin role
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "university id"
+// @param payload body dto.UniversityPatching true "university fields"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /admin/university/{id} [patch].
+func (a *AdminImpl) PatchUniversity(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	var payload dto.UniversityPatching
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	adminID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.adminService.PatchUniversity(
+		c.Request.Context(), adminID, uint(id), payload, getClientInfo(c),
+	); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// PatchDirection
+// @tags admin
+// @summary patches direction
+// @description updates passed direction fields, requires admin role
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "direction id"
+// @param payload body dto.DirectionPatching true "direction fields"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /admin/direction/{id} [patch].
+func (a *AdminImpl) PatchDirection(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	var payload dto.DirectionPatching
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	adminID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.adminService.PatchDirection(
+		c.Request.Context(), adminID, uint(id), payload, getClientInfo(c),
+	); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// PurgeRatingLists
+// @tags admin
+// @summary purges rating lists cache
+// @description deletes cached rating lists, so they are parsed again, requires admin role
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} dto.CachePurged
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @router /admin/cache/rating_lists [delete].
+func (a *AdminImpl) PurgeRatingLists(c *gin.Context) {
+	adminID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	purged, err := a.adminService.PurgeRatingLists(c.Request.Context(), adminID, getClientInfo(c))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, purged)
+}
@@ -0,0 +1,225 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type AlertRuleImpl struct {
+	validate         *validator.Validate
+	alertRuleService services.AlertRule
+}
+
+func NewAlertRuleImpl(validate *validator.Validate, alertRuleService services.AlertRule) *AlertRuleImpl {
+	return &AlertRuleImpl{
+		validate:         validate,
+		alertRuleService: alertRuleService,
+	}
+}
+
+// Create
+// @tags alert rule
+// @summary creates alert rule
+// @description receives tracked direction id and condition: metric (position, position_change,
+// @description submitted_consent_upper, priority_one_upper, within_budget), operator (gt, gte, lt, lte, eq, ne),
+// @description threshold and cooldown in seconds; alert is sent when condition becomes true after rating refresh
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.AlertRuleCreating true "alert rule"
+// @success 201 {object} dto.AlertRule
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @router /direction/alert_rules [post].
+func (a *AlertRuleImpl) Create(c *gin.Context) {
+	var payload dto.AlertRuleCreating
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	rule, err := a.alertRuleService.Create(c.Request.Context(), userID, payload)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, rule)
+}
+
+// GetForUser
+// @tags alert rule
+// @summary returns user alert rules
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.AlertRule
+// @failure 401 {object} apierrors.Problem
+// @router /direction/alert_rules [get].
+func (a *AlertRuleImpl) GetForUser(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	rules, err := a.alertRuleService.GetForUser(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+// Get
+// @tags alert rule
+// @summary returns user alert rule by id
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "alert rule id"
+// @success 200 {object} dto.AlertRule
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /direction/alert_rules/{id} [get].
+func (a *AlertRuleImpl) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	rule, err := a.alertRuleService.Get(c.Request.Context(), userID, uint(id))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// Update
+// @tags alert rule
+// @summary updates user alert rule
+// @description replaces condition of alert rule and resets its cooldown
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "alert rule id"
+// @param payload body dto.AlertRuleUpdating true "alert rule condition"
+// @success 200 {object} dto.AlertRule
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /direction/alert_rules/{id} [put].
+func (a *AlertRuleImpl) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	var payload dto.AlertRuleUpdating
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	rule, err := a.alertRuleService.Update(c.Request.Context(), userID, uint(id), payload)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// Delete
+// @tags alert rule
+// @summary deletes user alert rule
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "alert rule id"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /direction/alert_rules/{id} [delete].
+func (a *AlertRuleImpl) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.alertRuleService.Delete(c.Request.Context(), userID, uint(id)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type AuditLogImpl struct {
+	validate        *validator.Validate
+	auditLogService services.AuditLog
+}
+
+func NewAuditLogImpl(validate *validator.Validate, auditLogService services.AuditLog) *AuditLogImpl {
+	return &AuditLogImpl{
+		validate:        validate,
+		auditLogService: auditLogService,
+	}
+}
+
+// GetForUser
+// @tags audit log
+// @summary returns user security events
+// @description returns the latest sign ins, failed sign in attempts, logouts, password, profile
+// @description and directions changes of user with ip, user agent and request id
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.AuditLogRecord
+// @failure 401 {object} apierrors.Problem
+// @router /user/audit-log [get].
+func (a *AuditLogImpl) GetForUser(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	records, err := a.auditLogService.GetForUser(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, records)
+}
+
+// Find
+// @tags admin
+// @summary queries audit log
+// @description returns audit log records of all users filtered by user, action and time range (RFC 3339),
+// @description the latest records go first, requires admin role
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param user_id query int false "user id"
+// @param action query string false "action, e.g. user.sign_in_failed"
+// @param from query string false "records created at or after"
+// @param to query string false "records created before"
+// @param limit query int false "records count, 100 by default, 500 at most"
+// @param offset query int false "records offset"
+// @success 200 {object} []dto.AuditLogRecord
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @router /admin/audit-log [get].
+func (a *AuditLogImpl) Find(c *gin.Context) {
+	var query dto.AuditLogQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := query.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	records, err := a.auditLogService.Find(c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, records)
+}
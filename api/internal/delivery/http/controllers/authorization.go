@@ -4,7 +4,6 @@ import (
 	"net/http"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
-	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -14,10 +13,11 @@ import (
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
 )
 
+const jwksCacheControl = "public, max-age=300"
+
 type AuthorizationImpl struct {
 	validate             *validator.Validate
 	authorizationService services.Authorization
-	logger               *logging.Logger
 }
 
 func NewAuthorizationImpl(
@@ -27,7 +27,6 @@ func NewAuthorizationImpl(
 	return &AuthorizationImpl{
 		validate:             validate,
 		authorizationService: authorizationService,
-		logger:               logging.NewLogger("authorization controllers"),
 	}
 }
 
@@ -39,30 +38,27 @@ func NewAuthorizationImpl(
 // @produce json
 // @param payload body dto.SigningUp true "user credentials"
 // @success 201 {object} dto.IDResponse
-// @failure 400 {object} apierrors.APIError
-// @failure 409 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
 // @router /auth/sign-up [post].
 func (a *AuthorizationImpl) SignUp(c *gin.Context) {
 	var payload dto.SigningUp
 
-	if err := c.BindJSON(&payload); err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
 
 		return
 	}
 
 	if err := payload.Validate(a.validate); err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(apierrors.NewValidationError(err))
 
 		return
 	}
 
-	id, err := a.authorizationService.SignUpUser(payload)
+	id, err := a.authorizationService.SignUpUser(c.Request.Context(), payload, getClientInfo(c))
 	if err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusConflict, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -73,35 +69,83 @@ func (a *AuthorizationImpl) SignUp(c *gin.Context) {
 // SignIn
 // @tags authorization
 // @summary signs in user with jwt tokens response
-// @description receives user credentials and returns jwt access and refresh tokens
+// @description receives user credentials and returns jwt access and refresh tokens,
+// @description failed attempts delay the next ones and lock sign in, Retry-After header is set then.
+// @description If user has two-factor authentication enabled, challenge token is returned with 202 status,
+// @description it is exchanged for tokens by /auth/sign-in/two-factor
 // @accept json
 // @produce json
 // @param payload body dto.UserCredentials true "user credentials"
 // @success 200 {object} dto.AuthorizationTokens
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @success 202 {object} dto.TwoFactorChallenge
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 423 {object} apierrors.Problem
+// @failure 429 {object} apierrors.Problem
 // @router /auth/sign-in [post].
 func (a *AuthorizationImpl) SignIn(c *gin.Context) {
 	var payload dto.UserCredentials
 
-	if err := c.BindJSON(&payload); err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
 
 		return
 	}
 
 	if err := payload.Validate(a.validate); err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(apierrors.NewValidationError(err))
 
 		return
 	}
 
-	tokens, err := a.authorizationService.GenerateTokens(payload)
+	result, err := a.authorizationService.GenerateTokens(c.Request.Context(), payload, getClientInfo(c))
 	if err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
+
+		return
+	}
+
+	if result.Challenge != nil {
+		c.JSON(http.StatusAccepted, result.Challenge)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result.Tokens)
+}
+
+// SignInTwoFactor
+// @tags authorization
+// @summary finishes sign in with two-factor code
+// @description receives challenge token returned by sign in and TOTP or backup code,
+// @description returns jwt access and refresh tokens
+// @accept json
+// @produce json
+// @param payload body dto.TwoFactorSigningIn true "challenge token and code"
+// @success 200 {object} dto.AuthorizationTokens
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 423 {object} apierrors.Problem
+// @failure 429 {object} apierrors.Problem
+// @router /auth/sign-in/two-factor [post].
+func (a *AuthorizationImpl) SignInTwoFactor(c *gin.Context) {
+	var payload dto.TwoFactorSigningIn
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	tokens, err := a.authorizationService.SignInTwoFactor(c.Request.Context(), payload, getClientInfo(c))
+	if err != nil {
+		_ = c.Error(err)
 
 		return
 	}
@@ -112,25 +156,24 @@ func (a *AuthorizationImpl) SignIn(c *gin.Context) {
 // RefreshTokens
 // @tags authorization
 // @summary update jwt access and refresh tokens
-// @description receives refresh token header and returns updated jwt access and refresh tokens
+// @description receives refresh token header and returns updated jwt access and refresh tokens,
+// @description refresh token can be used only once, reusing it revokes the whole session
 // @produce json
 // @param RefreshToken header string true "refresh token header"
 // @success 200 {object} dto.AuthorizationTokens
-// @failure 401 {object} apierrors.APIError
+// @failure 401 {object} apierrors.Problem
 // @router /auth/refresh-tokens [get].
 func (a *AuthorizationImpl) RefreshTokens(c *gin.Context) {
 	refreshToken, err := middleware.GetRefreshTokenFromRequest(c)
 	if err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.InvalidRefreshToken)
+		_ = c.Error(apierrors.InvalidRefreshToken)
 
 		return
 	}
 
-	tokens, err := a.authorizationService.RefreshTokens(refreshToken)
+	tokens, err := a.authorizationService.RefreshTokens(c.Request.Context(), refreshToken, getClientInfo(c))
 	if err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -141,37 +184,185 @@ func (a *AuthorizationImpl) RefreshTokens(c *gin.Context) {
 // Logout
 // @tags authorization
 // @summary logout user
-// @description receives access token header and logouts user
+// @description receives access token header and revokes its session
 // @accept json
 // @produce json
 // @security AccessTokenHeader
 // @success 200 "logout success"
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /auth/logout [get].
 func (a *AuthorizationImpl) Logout(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
 	accessToken, err := middleware.GetAccessTokenFromRequest(c)
 	if err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.InvalidAuthorizationHeader)
+		_ = c.Error(apierrors.InvalidAuthorizationHeader)
 
 		return
 	}
 
-	if err := a.authorizationService.LogoutUser(userID, accessToken); err != nil {
-		a.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+	if err := a.authorizationService.LogoutUser(c.Request.Context(), userID, accessToken, getClientInfo(c)); err != nil {
+		_ = c.Error(err)
 
 		return
 	}
 
 	c.Status(http.StatusOK)
 }
+
+// ChangePassword
+// @tags authorization
+// @summary changes user password
+// @description receives current and new passwords, revokes all sessions, so devices have to sign in again
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.PasswordChanging true "current and new passwords"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @router /user/change-password [post].
+func (a *AuthorizationImpl) ChangePassword(c *gin.Context) {
+	var payload dto.PasswordChanging
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(a.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.authorizationService.ChangePassword(c.Request.Context(), userID, payload, getClientInfo(c)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// GetSessions
+// @tags authorization
+// @summary returns user sessions
+// @description returns active sessions of user devices, session of request access token is marked as current
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.Session
+// @failure 401 {object} apierrors.Problem
+// @router /user/sessions [get].
+func (a *AuthorizationImpl) GetSessions(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	sessionID, err := middleware.GetSessionID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	sessions, err := a.authorizationService.GetSessions(c.Request.Context(), userID, sessionID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// RevokeSession
+// @tags authorization
+// @summary revokes user session
+// @description revokes session, so its refresh token can't be used anymore
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path string true "session id"
+// @success 200 "success"
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /user/sessions/{id} [delete].
+func (a *AuthorizationImpl) RevokeSession(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.authorizationService.RevokeSession(c.Request.Context(), userID, c.Param("id")); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RevokeSessions
+// @tags authorization
+// @summary revokes all user sessions
+// @description revokes sessions of all user devices including current one
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 "success"
+// @failure 401 {object} apierrors.Problem
+// @router /user/sessions [delete].
+func (a *AuthorizationImpl) RevokeSessions(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := a.authorizationService.RevokeSessions(c.Request.Context(), userID); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// JWKS returns public keys verifying access tokens in JSON Web Key Set format, so other
+// services can verify tokens without sharing secrets. It is served on /.well-known/jwks.json
+// outside of API prefix, so it isn't described by swagger.
+func (a *AuthorizationImpl) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", jwksCacheControl)
+	c.JSON(http.StatusOK, a.authorizationService.GetJWKS())
+}
+
+func getClientInfo(c *gin.Context) dto.ClientInfo {
+	return dto.ClientInfo{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		RequestID: middleware.GetRequestID(c),
+	}
+}
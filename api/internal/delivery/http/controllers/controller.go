@@ -4,19 +4,72 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/graphql"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
 )
 
 type Authorization interface {
 	SignUp(c *gin.Context)
 	SignIn(c *gin.Context)
+	SignInTwoFactor(c *gin.Context)
 	RefreshTokens(c *gin.Context)
 	Logout(c *gin.Context)
+	ChangePassword(c *gin.Context)
+	GetSessions(c *gin.Context)
+	RevokeSession(c *gin.Context)
+	RevokeSessions(c *gin.Context)
+	JWKS(c *gin.Context)
+}
+
+type PasswordReset interface {
+	RequestReset(c *gin.Context)
+	ConfirmReset(c *gin.Context)
+}
+
+type OIDC interface {
+	SignInURL(c *gin.Context)
+	SignIn(c *gin.Context)
+	LinkURL(c *gin.Context)
+	Link(c *gin.Context)
+	GetIdentities(c *gin.Context)
+	Unlink(c *gin.Context)
+}
+
+type TwoFactor interface {
+	GetStatus(c *gin.Context)
+	Enroll(c *gin.Context)
+	Confirm(c *gin.Context)
+	Disable(c *gin.Context)
+	RegenerateBackupCodes(c *gin.Context)
+}
+
+type PersonalAccessToken interface {
+	Create(c *gin.Context)
+	GetForUser(c *gin.Context)
+	Revoke(c *gin.Context)
 }
 
 type User interface {
 	GetUsername(c *gin.Context)
 	GetProfile(c *gin.Context)
+	PatchProfile(c *gin.Context)
+}
+
+type Account interface {
+	Export(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type AuditLog interface {
+	GetForUser(c *gin.Context)
+	Find(c *gin.Context)
+}
+
+type Admin interface {
+	SetRole(c *gin.Context)
+	PatchUniversity(c *gin.Context)
+	PatchDirection(c *gin.Context)
+	PurgeRatingLists(c *gin.Context)
 }
 
 type University interface {
@@ -34,18 +87,94 @@ type Direction interface {
 	SetForUser(c *gin.Context)
 }
 
+type DirectionV2 interface {
+	Find(c *gin.Context)
+	GetForUser(c *gin.Context)
+	GetForUserByID(c *gin.Context)
+	AddForUser(c *gin.Context)
+	RemoveForUser(c *gin.Context)
+	GetForUniversity(c *gin.Context)
+}
+
+type LiveUpdates interface {
+	Stream(c *gin.Context)
+}
+
+type AlertRule interface {
+	Create(c *gin.Context)
+	GetForUser(c *gin.Context)
+	Get(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type Webhook interface {
+	Create(c *gin.Context)
+	GetForUser(c *gin.Context)
+	Delete(c *gin.Context)
+	GetDeliveries(c *gin.Context)
+}
+
+type Email interface {
+	RequestVerification(c *gin.Context)
+	PatchSettings(c *gin.Context)
+	Verify(c *gin.Context)
+	Unsubscribe(c *gin.Context)
+}
+
+type Telegram interface {
+	CreateLinkCode(c *gin.Context)
+	Unlink(c *gin.Context)
+}
+
+type GraphQL interface {
+	Execute(c *gin.Context)
+}
+
 type Controller struct {
 	Authorization
+	PasswordReset
+	OIDC
+	TwoFactor
+	PersonalAccessToken
 	User
+	Account
+	AuditLog
+	Admin
 	University
 	Direction
+	DirectionV2
+	GraphQL
+	LiveUpdates
+	AlertRule
+	Webhook
+	Email
+	Telegram
 }
 
-func NewController(validate *validator.Validate, services *services.Service) *Controller {
+func NewController(
+	validate *validator.Validate,
+	services *services.Service,
+	graphQLExecutor *graphql.Executor,
+) *Controller {
 	return &Controller{
-		Authorization: NewAuthorizationImpl(validate, services.Authorization),
-		User:          NewUserImpl(validate, services.User),
-		University:    NewUniversityImpl(validate, services.University),
-		Direction:     NewDirectionImpl(validate, services.Direction),
+		Authorization:       NewAuthorizationImpl(validate, services.Authorization),
+		PasswordReset:       NewPasswordResetImpl(validate, services.PasswordReset),
+		OIDC:                NewOIDCImpl(validate, services.OIDC),
+		TwoFactor:           NewTwoFactorImpl(validate, services.TwoFactor),
+		PersonalAccessToken: NewPersonalAccessTokenImpl(validate, services.PersonalAccessToken),
+		User:                NewUserImpl(validate, services.User),
+		Account:             NewAccountImpl(services.Account),
+		AuditLog:            NewAuditLogImpl(validate, services.AuditLog),
+		Admin:               NewAdminImpl(validate, services.Admin),
+		University:          NewUniversityImpl(validate, services.University),
+		Direction:           NewDirectionImpl(validate, services.Direction),
+		DirectionV2:         NewDirectionV2Impl(validate, services.Direction),
+		GraphQL:             NewGraphQLImpl(validate, graphQLExecutor),
+		LiveUpdates:         NewLiveUpdatesImpl(services.LiveUpdates),
+		AlertRule:           NewAlertRuleImpl(validate, services.AlertRule),
+		Webhook:             NewWebhookImpl(validate, services.Webhook),
+		Email:               NewEmailImpl(validate, services.Email),
+		Telegram:            NewTelegramImpl(validate, services.Telegram),
 	}
 }
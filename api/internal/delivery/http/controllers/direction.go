@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
-	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -15,46 +14,72 @@ import (
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
 )
 
+// NextCursorHeader contains cursor of the next page of directions catalogue.
+const NextCursorHeader = "X-Next-Cursor"
+
 type DirectionImpl struct {
 	validate         *validator.Validate
 	directionService services.Direction
-	logger           *logging.Logger
 }
 
 func NewDirectionImpl(validate *validator.Validate, directionService services.Direction) *DirectionImpl {
 	return &DirectionImpl{
 		validate:         validate,
 		directionService: directionService,
-		logger:           logging.NewLogger("direction controllers"),
 	}
 }
 
 // GetAll
 // @tags direction
-// @summary returns all directions
+// @summary returns directions
+// @description returns directions grouped by universities, all of them are returned without limit;
+// @description name is searched case-insensitively, code is prefix of specialty code, e.g. 09.03;
+// @description cursor of the next page is returned in X-Next-Cursor header
 // @accept json
 // @produce json
 // @security AccessTokenHeader
+// @param university_id query int false "university id"
+// @param search query string false "text in direction name"
+// @param code query string false "specialty code prefix"
+// @param cursor query string false "cursor of the page"
+// @param limit query int false "directions count, 200 at most"
 // @success 200 {object} []dto.UniversityDirections
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @header 200 {string} X-Next-Cursor "cursor of the next page"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /direction/ [get].
 func (u *DirectionImpl) GetAll(c *gin.Context) {
+	var query dto.DirectionQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := query.Validate(u.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
 	if _, err := middleware.GetUserID(c); err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	directions, err := u.directionService.GetAll()
+	directions, nextCursor, err := u.directionService.GetAll(c.Request.Context(), query)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
+	if nextCursor != "" {
+		c.Header(NextCursorHeader, nextCursor)
+	}
+
 	c.JSON(http.StatusOK, directions)
 }
 
@@ -66,22 +91,20 @@ func (u *DirectionImpl) GetAll(c *gin.Context) {
 // @security AccessTokenHeader
 // @param id path int true "direction id"
 // @success 200 {object} models.Direction
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /direction/{id} [get].
 func (u *DirectionImpl) Get(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.InvalidQueryIDParam)
+		_ = c.Error(apierrors.InvalidQueryIDParam)
 
 		return
 	}
 
-	university, err := u.directionService.GetByID(uint(id))
+	university, err := u.directionService.GetByID(c.Request.Context(), uint(id))
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -96,22 +119,20 @@ func (u *DirectionImpl) Get(c *gin.Context) {
 // @produce json
 // @security AccessTokenHeader
 // @success 200 {object} []dto.UniversityDirections
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /direction/get_for_user [get].
 func (u *DirectionImpl) GetForUser(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	directions, err := u.directionService.GetForUser(userID)
+	directions, err := u.directionService.GetForUser(c.Request.Context(), userID)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -126,22 +147,20 @@ func (u *DirectionImpl) GetForUser(c *gin.Context) {
 // @produce json
 // @security AccessTokenHeader
 // @success 200 {object} []dto.UniversityDirectionsWithRating
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /direction/get_for_user_with_rating [get].
 func (u *DirectionImpl) GetForUserWithRating(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	directionsWithRating, err := u.directionService.GetForUserWithRating(userID)
+	directionsWithRating, err := u.directionService.GetForUserWithRating(c.Request.Context(), userID)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -158,30 +177,27 @@ func (u *DirectionImpl) GetForUserWithRating(c *gin.Context) {
 // @security AccessTokenHeader
 // @param payload body dto.IDs true "direction ids"
 // @success 200 "success"
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /direction/set_for_user [post].
 func (u *DirectionImpl) SetForUser(c *gin.Context) {
 	var payload dto.IDs
 
-	if err := c.BindJSON(&payload); err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
 
 		return
 	}
 
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	if err := u.directionService.SetForUser(userID, payload); err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+	if err := u.directionService.SetForUser(c.Request.Context(), userID, payload, getClientInfo(c)); err != nil {
+		_ = c.Error(err)
 
 		return
 	}
@@ -0,0 +1,255 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+// DirectionV2Impl serves resource-style v2 routes, which track directions one by one.
+// Universities of user are derived from tracked directions, so they can't be set separately.
+type DirectionV2Impl struct {
+	validate         *validator.Validate
+	directionService services.Direction
+}
+
+func NewDirectionV2Impl(validate *validator.Validate, directionService services.Direction) *DirectionV2Impl {
+	return &DirectionV2Impl{
+		validate:         validate,
+		directionService: directionService,
+	}
+}
+
+// Find
+// @tags v2
+// @summary searches directions catalogue
+// @description returns page of directions ordered by id, name is searched case-insensitively,
+// @description code is prefix of specialty code, e.g. 09.03; next_cursor is omitted on the last page
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param university_id query int false "university id"
+// @param search query string false "text in direction name"
+// @param code query string false "specialty code prefix"
+// @param cursor query string false "cursor of the page"
+// @param limit query int false "directions count, 50 by default, 200 at most"
+// @success 200 {object} dto.DirectionsPage
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @router /v2/directions [get].
+func (d *DirectionV2Impl) Find(c *gin.Context) {
+	var query dto.DirectionQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := query.Validate(d.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	page, err := d.directionService.Find(c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetForUser
+// @tags v2
+// @summary returns directions tracked by user
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.UniversityDirection
+// @failure 401 {object} apierrors.Problem
+// @router /v2/me/directions [get].
+func (d *DirectionV2Impl) GetForUser(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	directions, err := d.directionService.GetTrackedForUser(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, directions)
+}
+
+// GetForUserByID
+// @tags v2
+// @summary returns direction tracked by user
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "direction id"
+// @success 200 {object} dto.UniversityDirection
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /v2/me/directions/{id} [get].
+func (d *DirectionV2Impl) GetForUserByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	direction, err := d.directionService.GetTrackedForUserByID(c.Request.Context(), userID, uint(id))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, direction)
+}
+
+// AddForUser
+// @tags v2
+// @summary starts tracking direction
+// @description adds direction to user directions, university of direction is added to user universities;
+// @description request is idempotent, 201 is returned if direction wasn't tracked and 200 otherwise
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "direction id"
+// @success 200 {object} dto.UniversityDirection
+// @success 201 {object} dto.UniversityDirection
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /v2/me/directions/{id} [put].
+func (d *DirectionV2Impl) AddForUser(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	added, err := d.directionService.AddForUser(c.Request.Context(), userID, uint(id), getClientInfo(c))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	direction, err := d.directionService.GetTrackedForUserByID(c.Request.Context(), userID, uint(id))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if added {
+		c.JSON(http.StatusCreated, direction)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, direction)
+}
+
+// RemoveForUser
+// @tags v2
+// @summary stops tracking direction
+// @description removes direction from user directions, university of direction is removed
+// @description from user universities if user doesn't track its other directions
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "direction id"
+// @success 204 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /v2/me/directions/{id} [delete].
+func (d *DirectionV2Impl) RemoveForUser(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := d.directionService.RemoveForUser(c.Request.Context(), userID, uint(id), getClientInfo(c)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetForUniversity
+// @tags v2
+// @summary returns university directions
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "university id"
+// @success 200 {object} []dto.UniversityDirection
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /v2/universities/{id}/directions [get].
+func (d *DirectionV2Impl) GetForUniversity(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	directions, err := d.directionService.GetForUniversity(c.Request.Context(), uint(id))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, directions)
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+const (
+	emailVerifiedMessage = "Адрес электронной почты подтверждён / Email address is verified"
+	unsubscribedMessage  = "Вы отписались от уведомлений / You are unsubscribed from notifications"
+)
+
+type EmailImpl struct {
+	validate     *validator.Validate
+	emailService services.Email
+}
+
+func NewEmailImpl(validate *validator.Validate, emailService services.Email) *EmailImpl {
+	return &EmailImpl{
+		validate:     validate,
+		emailService: emailService,
+	}
+}
+
+// RequestVerification
+// @tags email
+// @summary sets user email
+// @description receives email and sends verification link to it, email is set after following the link
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.EmailAddress true "email"
+// @success 202 "verification sent"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 429 {object} apierrors.Problem
+// @router /user/email [post].
+func (e *EmailImpl) RequestVerification(c *gin.Context) {
+	var payload dto.EmailAddress
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(e.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := e.emailService.RequestVerification(c.Request.Context(), userID, payload); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// PatchSettings
+// @tags email
+// @summary updates email notifications settings
+// @description receives language of emails and whether email notifications are enabled
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.EmailSettings true "email settings"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @router /user/email/settings [patch].
+func (e *EmailImpl) PatchSettings(c *gin.Context) {
+	var payload dto.EmailSettings
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(e.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := e.emailService.PatchSettings(c.Request.Context(), userID, payload); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// Verify
+// @tags email
+// @summary verifies user email
+// @description follows link from verification email and sets email to user
+// @produce plain
+// @param token query string true "verification token"
+// @success 200 {string} string "email is verified"
+// @failure 400 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /email/verify [get].
+func (e *EmailImpl) Verify(c *gin.Context) {
+	if err := e.emailService.Verify(c.Request.Context(), c.Query("token")); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.String(http.StatusOK, emailVerifiedMessage)
+}
+
+// Unsubscribe
+// @tags email
+// @summary unsubscribes user from email notifications
+// @description follows unsubscribe link from notification email
+// @produce plain
+// @param token query string true "unsubscribe token"
+// @success 200 {string} string "unsubscribed"
+// @failure 400 {object} apierrors.Problem
+// @router /email/unsubscribe [get].
+func (e *EmailImpl) Unsubscribe(c *gin.Context) {
+	if err := e.emailService.Unsubscribe(c.Request.Context(), c.Query("token")); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.String(http.StatusOK, unsubscribedMessage)
+}
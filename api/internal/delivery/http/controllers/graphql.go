@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/graphql"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type GraphQLImpl struct {
+	validate *validator.Validate
+	executor *graphql.Executor
+	logger   *logging.Logger
+}
+
+func NewGraphQLImpl(validate *validator.Validate, executor *graphql.Executor) *GraphQLImpl {
+	return &GraphQLImpl{
+		validate: validate,
+		executor: executor,
+		logger:   logging.NewLogger("graphql controllers"),
+	}
+}
+
+// Execute
+// @tags graphql
+// @summary executes graphql query
+// @description executes query over user (me), universities, directions, ratings and rating history;
+// @description operations deeper or more complex than configured limits are rejected,
+// @description operation errors are returned in errors field of result with 200 status
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.GraphQLRequest true "query, operation name and variables"
+// @success 200 {object} object "result with data and errors"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @router /graphql [post].
+func (g *GraphQLImpl) Execute(c *gin.Context) {
+	var request dto.GraphQLRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := request.Validate(g.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	result := g.executor.Execute(c.Request.Context(), userID, request)
+	for _, err := range result.Errors {
+		g.logger.Error(err)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+// pongWaitFactor is a number of heartbeat intervals to wait for pong before connection is considered dead.
+const pongWaitFactor = 2
+
+type LiveUpdatesImpl struct {
+	liveUpdatesService services.LiveUpdates
+	upgrader           websocket.Upgrader
+	cfg                *config.LiveUpdates
+	logger             *logging.Logger
+}
+
+func NewLiveUpdatesImpl(liveUpdatesService services.LiveUpdates) *LiveUpdatesImpl {
+	return &LiveUpdatesImpl{
+		liveUpdatesService: liveUpdatesService,
+		upgrader: websocket.Upgrader{
+			// Clients are authorized by access token, not by cookies, so any origin is allowed as with CORS.
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		cfg:    config.Get().LiveUpdates,
+		logger: logging.NewLogger("live updates controllers"),
+	}
+}
+
+// Stream
+// @tags direction
+// @summary streams user directions with rating
+// @description upgrades connection to WebSocket and sends user directions with rating as JSON text messages:
+// @description the latest known ones right after connecting and new ones after every rating refresh.
+// @description Server sends ping frames as heartbeats. Access token may be passed by access_token query parameter.
+// @produce json
+// @security AccessTokenHeader
+// @param access_token query string false "access token"
+// @success 101 {object} []dto.UniversityDirectionsWithRating
+// @failure 401 {object} apierrors.Problem
+// @router /direction/live [get].
+func (l *LiveUpdatesImpl) Stream(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	payloads, err := l.liveUpdatesService.Subscribe(ctx, userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	conn, err := l.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		l.logger.Error(err)
+
+		return
+	}
+	defer conn.Close()
+
+	go l.readControlMessages(conn, cancel)
+
+	latest, err := l.liveUpdatesService.GetLatest(c.Request.Context(), userID)
+	if err != nil {
+		l.logger.Error(err)
+	}
+
+	if latest != "" {
+		if err := l.write(conn, websocket.TextMessage, latest); err != nil {
+			l.logger.Error(err)
+
+			return
+		}
+	}
+
+	l.writeUpdates(ctx, conn, payloads)
+}
+
+func (l *LiveUpdatesImpl) writeUpdates(ctx context.Context, conn *websocket.Conn, payloads <-chan string) {
+	heartbeat := time.NewTicker(l.cfg.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-ctx.Done():
+			return
+		case payload, ok := <-payloads:
+			if !ok {
+				return
+			}
+
+			err = l.write(conn, websocket.TextMessage, payload)
+		case <-heartbeat.C:
+			err = l.write(conn, websocket.PingMessage, "")
+		}
+
+		if err != nil {
+			l.logger.Error(err)
+
+			return
+		}
+	}
+}
+
+// write sets write deadline for every message, so connection isn't closed by
+// server write timeout which is set for the whole request.
+func (l *LiveUpdatesImpl) write(conn *websocket.Conn, messageType int, data string) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(l.cfg.WriteTimeout)); err != nil {
+		return err
+	}
+
+	return conn.WriteMessage(messageType, []byte(data))
+}
+
+// readControlMessages reads messages to handle pongs and close frames and cancels
+// streaming when client disconnects or stops answering heartbeats.
+func (l *LiveUpdatesImpl) readControlMessages(conn *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+
+	pongWait := pongWaitFactor * l.cfg.HeartbeatInterval
+
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
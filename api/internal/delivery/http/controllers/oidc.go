@@ -0,0 +1,230 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type OIDCImpl struct {
+	validate    *validator.Validate
+	oidcService services.OIDC
+}
+
+func NewOIDCImpl(validate *validator.Validate, oidcService services.OIDC) *OIDCImpl {
+	return &OIDCImpl{
+		validate:    validate,
+		oidcService: oidcService,
+	}
+}
+
+// SignInURL
+// @tags oidc
+// @summary returns identity provider sign in url
+// @description starts authorization code flow with PKCE, user should be redirected to returned url,
+// @description after that provider redirects user back with code and state
+// @accept json
+// @produce json
+// @param provider path string true "provider name"
+// @success 200 {object} dto.OIDCAuthorizationURL
+// @failure 404 {object} apierrors.Problem
+// @router /auth/oidc/{provider}/authorize [get].
+func (o *OIDCImpl) SignInURL(c *gin.Context) {
+	url, err := o.oidcService.Authorize(c.Request.Context(), c.Param("provider"), 0)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, url)
+}
+
+// SignIn
+// @tags oidc
+// @summary signs in user with identity provider
+// @description receives code and state provider redirected user with and returns jwt access and refresh tokens,
+// @description user is created for unknown identity if auto provisioning is enabled.
+// @description Sign in is throttled as sign in by credentials, if user has two-factor authentication enabled,
+// @description challenge token is returned with 202 status, it is exchanged for tokens by /auth/sign-in/two-factor
+// @accept json
+// @produce json
+// @param provider path string true "provider name"
+// @param payload body dto.OIDCCallback true "authorization code and state"
+// @success 200 {object} dto.AuthorizationTokens
+// @success 202 {object} dto.TwoFactorChallenge
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @failure 423 {object} apierrors.Problem
+// @failure 429 {object} apierrors.Problem
+// @router /auth/oidc/{provider}/callback [post].
+func (o *OIDCImpl) SignIn(c *gin.Context) {
+	var payload dto.OIDCCallback
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(o.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	result, err := o.oidcService.SignIn(c.Request.Context(), c.Param("provider"), payload, getClientInfo(c))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if result.Challenge != nil {
+		c.JSON(http.StatusAccepted, result.Challenge)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result.Tokens)
+}
+
+// LinkURL
+// @tags oidc
+// @summary returns identity provider url for linking identity
+// @description starts authorization code flow with PKCE, identity authorized by provider is linked to user
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param provider path string true "provider name"
+// @success 200 {object} dto.OIDCAuthorizationURL
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /user/oidc/{provider}/authorize [get].
+func (o *OIDCImpl) LinkURL(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	url, err := o.oidcService.Authorize(c.Request.Context(), c.Param("provider"), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, url)
+}
+
+// Link
+// @tags oidc
+// @summary links identity to user
+// @description receives code and state provider redirected user with and links identity to user
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param provider path string true "provider name"
+// @param payload body dto.OIDCCallback true "authorization code and state"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/oidc/{provider}/callback [post].
+func (o *OIDCImpl) Link(c *gin.Context) {
+	var payload dto.OIDCCallback
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(o.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := o.oidcService.Link(c.Request.Context(), userID, c.Param("provider"), payload); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// GetIdentities
+// @tags oidc
+// @summary returns user identities
+// @description returns identity provider accounts linked to user
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.UserIdentity
+// @failure 401 {object} apierrors.Problem
+// @router /user/oidc [get].
+func (o *OIDCImpl) GetIdentities(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	identities, err := o.oidcService.GetIdentities(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, identities)
+}
+
+// Unlink
+// @tags oidc
+// @summary unlinks identity from user
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param provider path string true "provider name"
+// @success 200 "success"
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /user/oidc/{provider} [delete].
+func (o *OIDCImpl) Unlink(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := o.oidcService.Unlink(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+)
+
+type PasswordResetImpl struct {
+	validate             *validator.Validate
+	passwordResetService services.PasswordReset
+}
+
+func NewPasswordResetImpl(validate *validator.Validate, passwordResetService services.PasswordReset) *PasswordResetImpl {
+	return &PasswordResetImpl{
+		validate:             validate,
+		passwordResetService: passwordResetService,
+	}
+}
+
+// RequestReset
+// @tags authorization
+// @summary requests password reset
+// @description receives username and sends single-use reset link to user verified email or linked Telegram,
+// @description response is the same whether user exists or not
+// @accept json
+// @produce json
+// @param payload body dto.PasswordResetRequesting true "username"
+// @success 202 "accepted"
+// @failure 400 {object} apierrors.Problem
+// @failure 429 {object} apierrors.Problem
+// @router /auth/password-reset [post].
+func (p *PasswordResetImpl) RequestReset(c *gin.Context) {
+	var payload dto.PasswordResetRequesting
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(p.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := p.passwordResetService.RequestReset(c.Request.Context(), payload); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// ConfirmReset
+// @tags authorization
+// @summary sets new password by reset token
+// @description receives reset token and new password, sets it and revokes all user sessions
+// @accept json
+// @produce json
+// @param payload body dto.PasswordResetConfirming true "reset token and new password"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @router /auth/password-reset/confirm [post].
+func (p *PasswordResetImpl) ConfirmReset(c *gin.Context) {
+	var payload dto.PasswordResetConfirming
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(p.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := p.passwordResetService.ConfirmReset(c.Request.Context(), payload); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type PersonalAccessTokenImpl struct {
+	validate                   *validator.Validate
+	personalAccessTokenService services.PersonalAccessToken
+}
+
+func NewPersonalAccessTokenImpl(
+	validate *validator.Validate,
+	personalAccessTokenService services.PersonalAccessToken,
+) *PersonalAccessTokenImpl {
+	return &PersonalAccessTokenImpl{
+		validate:                   validate,
+		personalAccessTokenService: personalAccessTokenService,
+	}
+}
+
+// Create
+// @tags personal access token
+// @summary creates personal access token
+// @description creates token for scripts and integrations with given scopes, rate limit (requests per minute)
+// @description and optional expiration time, token value is returned only once
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.PersonalAccessTokenCreating true "token name, scopes, rate limit and expiration"
+// @success 201 {object} dto.CreatedPersonalAccessToken
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/tokens [post].
+func (p *PersonalAccessTokenImpl) Create(c *gin.Context) {
+	var payload dto.PersonalAccessTokenCreating
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(p.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	token, err := p.personalAccessTokenService.Create(c.Request.Context(), userID, payload)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, token)
+}
+
+// GetForUser
+// @tags personal access token
+// @summary returns user personal access tokens
+// @description returns tokens with their scopes, rate limits and last usage time, token values aren't returned
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.PersonalAccessToken
+// @failure 401 {object} apierrors.Problem
+// @router /user/tokens [get].
+func (p *PersonalAccessTokenImpl) GetForUser(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	tokens, err := p.personalAccessTokenService.GetForUser(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// Revoke
+// @tags personal access token
+// @summary revokes personal access token
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "token id"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /user/tokens/{id} [delete].
+func (p *PersonalAccessTokenImpl) Revoke(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := p.personalAccessTokenService.Revoke(c.Request.Context(), userID, uint(id)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type TelegramImpl struct {
+	validate        *validator.Validate
+	telegramService services.Telegram
+}
+
+func NewTelegramImpl(validate *validator.Validate, telegramService services.Telegram) *TelegramImpl {
+	return &TelegramImpl{
+		validate:        validate,
+		telegramService: telegramService,
+	}
+}
+
+// CreateLinkCode
+// @tags telegram
+// @summary creates telegram link code
+// @description returns one-time code which links telegram chat to user after being sent to the bot
+// @produce json
+// @security AccessTokenHeader
+// @success 201 {object} dto.TelegramLinkCode
+// @failure 401 {object} apierrors.Problem
+// @router /user/telegram/link_code [post].
+func (t *TelegramImpl) CreateLinkCode(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	linkCode, err := t.telegramService.CreateLinkCode(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, linkCode)
+}
+
+// Unlink
+// @tags telegram
+// @summary unlinks telegram
+// @description unlinks telegram chat from user, alerts are no longer sent to it
+// @produce json
+// @security AccessTokenHeader
+// @success 200 "success"
+// @failure 401 {object} apierrors.Problem
+// @router /user/telegram [delete].
+func (t *TelegramImpl) Unlink(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := t.telegramService.Unlink(c.Request.Context(), userID); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
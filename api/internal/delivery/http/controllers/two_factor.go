@@ -0,0 +1,200 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type TwoFactorImpl struct {
+	validate         *validator.Validate
+	twoFactorService services.TwoFactor
+}
+
+func NewTwoFactorImpl(validate *validator.Validate, twoFactorService services.TwoFactor) *TwoFactorImpl {
+	return &TwoFactorImpl{
+		validate:         validate,
+		twoFactorService: twoFactorService,
+	}
+}
+
+// GetStatus
+// @tags two-factor
+// @summary returns two-factor authentication status
+// @description returns whether TOTP is enabled and count of not used backup codes
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} dto.TwoFactorStatus
+// @failure 401 {object} apierrors.Problem
+// @router /user/two-factor [get].
+func (t *TwoFactorImpl) GetStatus(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	status, err := t.twoFactorService.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// Enroll
+// @tags two-factor
+// @summary enrolls TOTP
+// @description generates TOTP secret and provisioning uri for QR code, TOTP is enabled
+// @description only after it is confirmed by code
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} dto.TOTPEnrollment
+// @failure 401 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/two-factor/totp [post].
+func (t *TwoFactorImpl) Enroll(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	enrollment, err := t.twoFactorService.Enroll(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, enrollment)
+}
+
+// Confirm
+// @tags two-factor
+// @summary confirms TOTP enrollment
+// @description receives TOTP code of enrolled secret, enables two-factor authentication
+// @description and returns backup codes, which are shown only once
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.TwoFactorCode true "TOTP code"
+// @success 200 {object} dto.BackupCodes
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/two-factor/totp/confirm [post].
+func (t *TwoFactorImpl) Confirm(c *gin.Context) {
+	userID, payload, ok := t.bindCode(c)
+	if !ok {
+		return
+	}
+
+	backupCodes, err := t.twoFactorService.Confirm(c.Request.Context(), userID, payload)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, backupCodes)
+}
+
+// Disable
+// @tags two-factor
+// @summary disables two-factor authentication
+// @description receives TOTP or backup code, deletes TOTP secret and backup codes
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.TwoFactorCode true "TOTP or backup code"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/two-factor/disable [post].
+func (t *TwoFactorImpl) Disable(c *gin.Context) {
+	userID, payload, ok := t.bindCode(c)
+	if !ok {
+		return
+	}
+
+	if err := t.twoFactorService.Disable(c.Request.Context(), userID, payload); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RegenerateBackupCodes
+// @tags two-factor
+// @summary regenerates backup codes
+// @description receives TOTP code, replaces backup codes with new ones
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.TwoFactorCode true "TOTP code"
+// @success 200 {object} dto.BackupCodes
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 403 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/two-factor/backup-codes [post].
+func (t *TwoFactorImpl) RegenerateBackupCodes(c *gin.Context) {
+	userID, payload, ok := t.bindCode(c)
+	if !ok {
+		return
+	}
+
+	backupCodes, err := t.twoFactorService.RegenerateBackupCodes(c.Request.Context(), userID, payload)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, backupCodes)
+}
+
+// bindCode binds and validates code payload of identified user, request is aborted if it fails.
+func (t *TwoFactorImpl) bindCode(c *gin.Context) (uint, dto.TwoFactorCode, bool) {
+	var payload dto.TwoFactorCode
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return 0, payload, false
+	}
+
+	if err := payload.Validate(t.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return 0, payload, false
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return 0, payload, false
+	}
+
+	return userID, payload, true
+}
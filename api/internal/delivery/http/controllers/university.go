@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
-	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -18,14 +17,12 @@ import (
 type UniversityImpl struct {
 	validate          *validator.Validate
 	universityService services.University
-	logger            *logging.Logger
 }
 
 func NewUniversityImpl(validate *validator.Validate, universityService services.University) *UniversityImpl {
 	return &UniversityImpl{
 		validate:          validate,
 		universityService: universityService,
-		logger:            logging.NewLogger("university controllers"),
 	}
 }
 
@@ -36,14 +33,13 @@ func NewUniversityImpl(validate *validator.Validate, universityService services.
 // @produce json
 // @security AccessTokenHeader
 // @success 200 {object} []rdto.University
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /university/ [get].
 func (u *UniversityImpl) GetAll(c *gin.Context) {
-	universities, err := u.universityService.GetAll()
+	universities, err := u.universityService.GetAll(c.Request.Context())
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -59,22 +55,20 @@ func (u *UniversityImpl) GetAll(c *gin.Context) {
 // @security AccessTokenHeader
 // @param id path int true "university id"
 // @success 200 {object} models.University
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /university/{id} [get].
 func (u *UniversityImpl) Get(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.InvalidQueryIDParam)
+		_ = c.Error(apierrors.InvalidQueryIDParam)
 
 		return
 	}
 
-	university, err := u.universityService.GetByID(uint(id))
+	university, err := u.universityService.GetByID(c.Request.Context(), uint(id))
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -89,22 +83,20 @@ func (u *UniversityImpl) Get(c *gin.Context) {
 // @produce json
 // @security AccessTokenHeader
 // @success 200 {object} []rdto.University
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /university/get_for_user [get].
 func (u *UniversityImpl) GetForUser(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	universities, err := u.universityService.GetForUser(userID)
+	universities, err := u.universityService.GetForUser(c.Request.Context(), userID)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -121,30 +113,27 @@ func (u *UniversityImpl) GetForUser(c *gin.Context) {
 // @security AccessTokenHeader
 // @param payload body dto.IDs true "university ids"
 // @success 200 "success"
-// @failure 400 {object} apierrors.APIError
-// @failure 401 {object} apierrors.APIError
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
 // @router /university/set_for_user [post].
 func (u *UniversityImpl) SetForUser(c *gin.Context) {
 	var payload dto.IDs
 
-	if err := c.BindJSON(&payload); err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
 
 		return
 	}
 
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	if err := u.universityService.SetForUser(userID, payload); err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusBadRequest, apierrors.NewAPIError(err))
+	if err := u.universityService.SetForUser(c.Request.Context(), userID, payload); err != nil {
+		_ = c.Error(err)
 
 		return
 	}
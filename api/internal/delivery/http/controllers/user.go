@@ -4,26 +4,24 @@ import (
 	"net/http"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
-	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
 )
 
 type UserImpl struct {
 	validate    *validator.Validate
 	userService services.User
-	logger      *logging.Logger
 }
 
 func NewUserImpl(validate *validator.Validate, userService services.User) *UserImpl {
 	return &UserImpl{
 		validate:    validate,
 		userService: userService,
-		logger:      logging.NewLogger("user controllers"),
 	}
 }
 
@@ -35,21 +33,19 @@ func NewUserImpl(validate *validator.Validate, userService services.User) *UserI
 // @produce json
 // @security AccessTokenHeader
 // @success 200 {object} dto.Username
-// @failure 401 {object} apierrors.APIError
+// @failure 401 {object} apierrors.Problem
 // @router /user/get_username [get].
 func (u *UserImpl) GetUsername(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	username, err := u.userService.GetUsername(userID)
+	username, err := u.userService.GetUsername(c.Request.Context(), userID)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusInternalServerError, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
@@ -60,29 +56,72 @@ func (u *UserImpl) GetUsername(c *gin.Context) {
 // GetProfile
 // @tags user
 // @summary returns user profile
-// @description returns user username, firstname, lastname, middlename and snils
+// @description returns user username, firstname, lastname, middlename and masked snils
 // @accept json
 // @produce json
 // @security AccessTokenHeader
 // @success 200 {object} dto.UserProfile
-// @failure 401 {object} apierrors.APIError
+// @failure 401 {object} apierrors.Problem
 // @router /user/get_profile [get].
 func (u *UserImpl) GetProfile(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
-	profile, err := u.userService.GetProfile(userID)
+	profile, err := u.userService.GetProfile(c.Request.Context(), userID)
 	if err != nil {
-		u.logger.Error(err)
-		c.AbortWithStatusJSON(http.StatusInternalServerError, apierrors.NewAPIError(err))
+		_ = c.Error(err)
 
 		return
 	}
 
 	c.JSON(http.StatusOK, profile)
 }
+
+// PatchProfile
+// @tags user
+// @summary updates user profile
+// @description receives firstname, lastname, middlename and snils, only passed fields are updated;
+// @description changing of snils clears user rating history
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.UserPatching true "user profile fields"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 409 {object} apierrors.Problem
+// @router /user/profile [patch].
+func (u *UserImpl) PatchProfile(c *gin.Context) {
+	var payload dto.UserPatching
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(u.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := u.userService.PatchProfile(c.Request.Context(), userID, payload, getClientInfo(c)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
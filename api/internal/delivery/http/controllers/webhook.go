@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type WebhookImpl struct {
+	validate       *validator.Validate
+	webhookService services.Webhook
+}
+
+func NewWebhookImpl(validate *validator.Validate, webhookService services.Webhook) *WebhookImpl {
+	return &WebhookImpl{
+		validate:       validate,
+		webhookService: webhookService,
+	}
+}
+
+// Create
+// @tags webhook
+// @summary registers webhook
+// @description receives url which will be notified about user rating changes by signed POST requests,
+// @description returns webhook with secret for verifying X-RLMP-Signature header
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param payload body dto.WebhookCreating true "webhook url"
+// @success 201 {object} dto.WebhookCreated
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @router /webhook/ [post].
+func (w *WebhookImpl) Create(c *gin.Context) {
+	var payload dto.WebhookCreating
+
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	if err := payload.Validate(w.validate); err != nil {
+		_ = c.Error(apierrors.NewValidationError(err))
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	webhook, err := w.webhookService.Create(c.Request.Context(), userID, payload)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// GetForUser
+// @tags webhook
+// @summary returns user webhooks
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @success 200 {object} []dto.Webhook
+// @failure 401 {object} apierrors.Problem
+// @router /webhook/ [get].
+func (w *WebhookImpl) GetForUser(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	webhooks, err := w.webhookService.GetForUser(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+// Delete
+// @tags webhook
+// @summary deletes user webhook
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "webhook id"
+// @success 200 "success"
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /webhook/{id} [delete].
+func (w *WebhookImpl) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	if err := w.webhookService.Delete(c.Request.Context(), userID, uint(id)); err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// GetDeliveries
+// @tags webhook
+// @summary returns webhook delivery log
+// @description returns latest deliveries of webhook with their status, attempts and last response
+// @accept json
+// @produce json
+// @security AccessTokenHeader
+// @param id path int true "webhook id"
+// @success 200 {object} []dto.WebhookDelivery
+// @failure 400 {object} apierrors.Problem
+// @failure 401 {object} apierrors.Problem
+// @failure 404 {object} apierrors.Problem
+// @router /webhook/{id}/deliveries [get].
+func (w *WebhookImpl) GetDeliveries(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		_ = c.Error(apierrors.InvalidQueryIDParam)
+
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	deliveries, err := w.webhookService.GetDeliveries(c.Request.Context(), userID, uint(id))
+	if err != nil {
+		_ = c.Error(err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
@@ -1,40 +1,70 @@
 package http
 
 import (
+	"fmt"
+	"net/http"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	_ "github.com/ythosa/rating-list-monitoring-platform-api/docs" // swagger documentation
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/graphql"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/delivery/http/controllers"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
 )
 
 type Handler struct {
 	services    *services.Service
 	validate    *validator.Validate
 	controllers *controllers.Controller
+	tracing     *tracing.Provider
 }
 
-func NewHandler(services *services.Service, validate *validator.Validate) *Handler {
+func NewHandler(
+	services *services.Service,
+	validate *validator.Validate,
+	graphQLExecutor *graphql.Executor,
+	tracing *tracing.Provider,
+) *Handler {
 	return &Handler{
 		services:    services,
 		validate:    validate,
-		controllers: controllers.NewController(validate, services),
+		controllers: controllers.NewController(validate, services, graphQLExecutor),
+		tracing:     tracing,
 	}
 }
 
-func (h *Handler) InitRoutes() *gin.Engine {
-	router := gin.Default()
+// InitRoutes returns router of api, client ip of requests is taken from X-Forwarded-For header only
+// if request is sent by one of trusted proxies (nginx), otherwise it's ip of connection.
+func (h *Handler) InitRoutes(trustedProxies []string) (*gin.Engine, error) {
+	router := gin.New()
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, fmt.Errorf("error while setting trusted proxies: %w", err)
+	}
 
+	router.Use(gin.Recovery())
+	router.Use(middleware.RequestID)
+	router.Use(otelgin.Middleware(h.tracing.ServiceName()))
+	router.Use(middleware.Logger)
+	router.Use(middleware.ErrorHandler)
 	router.Use(getCORSConfig())
 	router.Use(middleware.NewMetricsMiddleware("/metrics").Metrics())
 
 	router.GET("/metrics", prometheusHandler())
+	router.GET("/.well-known/jwks.json", h.controllers.Authorization.JWKS)
+
+	identity := middleware.NewIdentity(
+		h.services.Authorization, h.services.Authorization, h.services.PersonalAccessToken, personalAccessTokenScopes(),
+	)
+	requireAdmin := middleware.RequireRole(authorization.RoleAdmin)
 
 	api := router.Group("/api")
 	{
@@ -47,17 +77,52 @@ func (h *Handler) InitRoutes() *gin.Engine {
 		{
 			authorization.POST("/sign-up", h.controllers.Authorization.SignUp)
 			authorization.POST("/sign-in", h.controllers.Authorization.SignIn)
+			authorization.POST("/sign-in/two-factor", h.controllers.Authorization.SignInTwoFactor)
 			authorization.GET("/refresh-tokens", h.controllers.Authorization.RefreshTokens)
-			authorization.GET("/logout", middleware.UserIdentity, h.controllers.Authorization.Logout)
+			authorization.GET("/logout", identity.UserIdentity, h.controllers.Authorization.Logout)
+			authorization.POST("/password-reset", h.controllers.PasswordReset.RequestReset)
+			authorization.POST("/password-reset/confirm", h.controllers.PasswordReset.ConfirmReset)
+			authorization.GET("/oidc/:provider/authorize", h.controllers.OIDC.SignInURL)
+			authorization.POST("/oidc/:provider/callback", h.controllers.OIDC.SignIn)
 		}
 
-		user := api.Group("/user", middleware.UserIdentity)
+		user := api.Group("/user", identity.UserIdentity)
 		{
 			user.GET("/get_username", h.controllers.User.GetUsername)
 			user.GET("/get_profile", h.controllers.User.GetProfile)
+			user.PATCH("/profile", h.controllers.User.PatchProfile)
+			user.GET("/export", h.controllers.Account.Export)
+			user.GET("/audit-log", h.controllers.AuditLog.GetForUser)
+			user.DELETE("", h.controllers.Account.Delete)
+			user.POST("/change-password", h.controllers.Authorization.ChangePassword)
+			user.GET("/sessions", h.controllers.Authorization.GetSessions)
+			user.DELETE("/sessions", h.controllers.Authorization.RevokeSessions)
+			user.DELETE("/sessions/:id", h.controllers.Authorization.RevokeSession)
+			user.POST("/email", h.controllers.Email.RequestVerification)
+			user.PATCH("/email/settings", h.controllers.Email.PatchSettings)
+			user.POST("/telegram/link_code", h.controllers.Telegram.CreateLinkCode)
+			user.DELETE("/telegram", h.controllers.Telegram.Unlink)
+			user.GET("/oidc", h.controllers.OIDC.GetIdentities)
+			user.GET("/oidc/:provider/authorize", h.controllers.OIDC.LinkURL)
+			user.POST("/oidc/:provider/callback", h.controllers.OIDC.Link)
+			user.DELETE("/oidc/:provider", h.controllers.OIDC.Unlink)
+			user.GET("/two-factor", h.controllers.TwoFactor.GetStatus)
+			user.POST("/two-factor/totp", h.controllers.TwoFactor.Enroll)
+			user.POST("/two-factor/totp/confirm", h.controllers.TwoFactor.Confirm)
+			user.POST("/two-factor/disable", h.controllers.TwoFactor.Disable)
+			user.POST("/two-factor/backup-codes", h.controllers.TwoFactor.RegenerateBackupCodes)
+			user.GET("/tokens", h.controllers.PersonalAccessToken.GetForUser)
+			user.POST("/tokens", h.controllers.PersonalAccessToken.Create)
+			user.DELETE("/tokens/:id", h.controllers.PersonalAccessToken.Revoke)
+		}
+
+		email := api.Group("/email")
+		{
+			email.GET("/verify", h.controllers.Email.Verify)
+			email.GET("/unsubscribe", h.controllers.Email.Unsubscribe)
 		}
 
-		university := api.Group("/university", middleware.UserIdentity)
+		university := api.Group("/university", identity.UserIdentity)
 		{
 			university.GET("/", h.controllers.University.GetAll)
 			university.GET("/:id", h.controllers.University.Get)
@@ -65,17 +130,86 @@ func (h *Handler) InitRoutes() *gin.Engine {
 			university.POST("/set_for_user", h.controllers.University.SetForUser)
 		}
 
-		direction := api.Group("/direction", middleware.UserIdentity)
+		api.GET("/direction/live", identity.StreamUserIdentity, h.controllers.LiveUpdates.Stream)
+		api.POST("/graphql", identity.UserIdentity, h.controllers.GraphQL.Execute)
+
+		direction := api.Group("/direction", identity.UserIdentity)
 		{
 			direction.GET("/", h.controllers.Direction.GetAll)
 			direction.GET("/:id", h.controllers.Direction.Get)
 			direction.GET("/get_for_user", h.controllers.Direction.GetForUser)
 			direction.GET("/get_for_user_with_rating", h.controllers.Direction.GetForUserWithRating)
 			direction.POST("/set_for_user", h.controllers.Direction.SetForUser)
+			direction.GET("/alert_rules", h.controllers.AlertRule.GetForUser)
+			direction.POST("/alert_rules", h.controllers.AlertRule.Create)
+			direction.GET("/alert_rules/:id", h.controllers.AlertRule.Get)
+			direction.PUT("/alert_rules/:id", h.controllers.AlertRule.Update)
+			direction.DELETE("/alert_rules/:id", h.controllers.AlertRule.Delete)
+		}
+
+		webhook := api.Group("/webhook", identity.UserIdentity)
+		{
+			webhook.GET("/", h.controllers.Webhook.GetForUser)
+			webhook.POST("/", h.controllers.Webhook.Create)
+			webhook.DELETE("/:id", h.controllers.Webhook.Delete)
+			webhook.GET("/:id/deliveries", h.controllers.Webhook.GetDeliveries)
+		}
+
+		admin := api.Group("/admin", identity.UserIdentity, requireAdmin)
+		{
+			admin.GET("/audit-log", h.controllers.AuditLog.Find)
+			admin.PUT("/user/:id/role", h.controllers.Admin.SetRole)
+			admin.PATCH("/university/:id", h.controllers.Admin.PatchUniversity)
+			admin.PATCH("/direction/:id", h.controllers.Admin.PatchDirection)
+			admin.DELETE("/cache/rating_lists", h.controllers.Admin.PurgeRatingLists)
+		}
+
+		v2 := api.Group("/v2", identity.UserIdentity)
+		{
+			v2.GET("/directions", h.controllers.DirectionV2.Find)
+			v2.GET("/me/directions", h.controllers.DirectionV2.GetForUser)
+			v2.GET("/me/directions/:id", h.controllers.DirectionV2.GetForUserByID)
+			v2.PUT("/me/directions/:id", h.controllers.DirectionV2.AddForUser)
+			v2.DELETE("/me/directions/:id", h.controllers.DirectionV2.RemoveForUser)
+			v2.GET("/universities/:id/directions", h.controllers.DirectionV2.GetForUniversity)
 		}
 	}
 
-	return router
+	return router, nil
+}
+
+// personalAccessTokenScopes returns scopes required from personal access tokens by routes.
+// Routes which aren't listed (e.g. password, sessions, tokens or admin ones) require jwt access token.
+func personalAccessTokenScopes() middleware.RouteScopes {
+	return middleware.RouteScopes{
+		middleware.Route(http.MethodGet, "/api/user/get_username"):                  authorization.ScopeReadProfile,
+		middleware.Route(http.MethodGet, "/api/user/get_profile"):                   authorization.ScopeReadProfile,
+		middleware.Route(http.MethodGet, "/api/university/"):                        authorization.ScopeReadUniversities,
+		middleware.Route(http.MethodGet, "/api/university/:id"):                     authorization.ScopeReadUniversities,
+		middleware.Route(http.MethodGet, "/api/university/get_for_user"):            authorization.ScopeReadUniversities,
+		middleware.Route(http.MethodPost, "/api/university/set_for_user"):           authorization.ScopeWriteUniversities,
+		middleware.Route(http.MethodGet, "/api/direction/live"):                     authorization.ScopeReadRatings,
+		middleware.Route(http.MethodGet, "/api/direction/"):                         authorization.ScopeReadDirections,
+		middleware.Route(http.MethodGet, "/api/direction/:id"):                      authorization.ScopeReadDirections,
+		middleware.Route(http.MethodGet, "/api/direction/get_for_user"):             authorization.ScopeReadDirections,
+		middleware.Route(http.MethodGet, "/api/direction/get_for_user_with_rating"): authorization.ScopeReadRatings,
+		middleware.Route(http.MethodPost, "/api/direction/set_for_user"):            authorization.ScopeWriteDirections,
+		middleware.Route(http.MethodGet, "/api/direction/alert_rules"):              authorization.ScopeReadAlertRules,
+		middleware.Route(http.MethodPost, "/api/direction/alert_rules"):             authorization.ScopeWriteAlertRules,
+		middleware.Route(http.MethodGet, "/api/direction/alert_rules/:id"):          authorization.ScopeReadAlertRules,
+		middleware.Route(http.MethodPut, "/api/direction/alert_rules/:id"):          authorization.ScopeWriteAlertRules,
+		middleware.Route(http.MethodDelete, "/api/direction/alert_rules/:id"):       authorization.ScopeWriteAlertRules,
+		middleware.Route(http.MethodGet, "/api/webhook/"):                           authorization.ScopeReadWebhooks,
+		middleware.Route(http.MethodPost, "/api/webhook/"):                          authorization.ScopeWriteWebhooks,
+		middleware.Route(http.MethodDelete, "/api/webhook/:id"):                     authorization.ScopeWriteWebhooks,
+		middleware.Route(http.MethodGet, "/api/webhook/:id/deliveries"):             authorization.ScopeReadWebhooks,
+		middleware.Route(http.MethodGet, "/api/v2/directions"):                      authorization.ScopeReadDirections,
+		middleware.Route(http.MethodGet, "/api/v2/me/directions"):                   authorization.ScopeReadDirections,
+		middleware.Route(http.MethodGet, "/api/v2/me/directions/:id"):               authorization.ScopeReadDirections,
+		middleware.Route(http.MethodPut, "/api/v2/me/directions/:id"):               authorization.ScopeWriteDirections,
+		middleware.Route(http.MethodDelete, "/api/v2/me/directions/:id"):            authorization.ScopeWriteDirections,
+		middleware.Route(http.MethodGet, "/api/v2/universities/:id/directions"):     authorization.ScopeReadUniversities,
+	}
 }
 
 func getCORSConfig() gin.HandlerFunc {
@@ -90,7 +224,9 @@ func getCORSConfig() gin.HandlerFunc {
 		"Access-Control-Request-Method",
 		"Access-Control-Request-Headers",
 		"Authorization",
+		middleware.RequestIDHeader,
 	}
+	config.ExposeHeaders = []string{middleware.RequestIDHeader, controllers.NextCursorHeader}
 
 	return cors.New(config)
 }
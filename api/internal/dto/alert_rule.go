@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+// Metrics of alert rules. Position change is an absolute difference between
+// previous and current positions, within budget is 1 if user is within budget places and 0 otherwise.
+const (
+	PositionMetric              = "position"
+	PositionChangeMetric        = "position_change"
+	SubmittedConsentUpperMetric = "submitted_consent_upper"
+	PriorityOneUpperMetric      = "priority_one_upper"
+	WithinBudgetMetric          = "within_budget"
+)
+
+const (
+	GreaterOperator        = "gt"
+	GreaterOrEqualOperator = "gte"
+	LessOperator           = "lt"
+	LessOrEqualOperator    = "lte"
+	EqualOperator          = "eq"
+	NotEqualOperator       = "ne"
+)
+
+type AlertRule struct {
+	ID              uint       `json:"id"`
+	DirectionID     uint       `json:"direction_id"`
+	Metric          string     `json:"metric"`
+	Operator        string     `json:"operator"`
+	Threshold       int        `json:"threshold"`
+	Cooldown        int        `json:"cooldown"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func NewAlertRule(r models.AlertRule) AlertRule {
+	rule := AlertRule{
+		ID:          r.ID,
+		DirectionID: r.DirectionID,
+		Metric:      r.Metric,
+		Operator:    r.Operator,
+		Threshold:   r.Threshold,
+		Cooldown:    r.Cooldown,
+		CreatedAt:   r.CreatedAt,
+	}
+
+	if r.LastTriggeredAt.Valid {
+		rule.LastTriggeredAt = &r.LastTriggeredAt.Time
+	}
+
+	return rule
+}
+
+type TriggeredAlert struct {
+	RuleID    uint   `json:"rule_id"`
+	Metric    string `json:"metric"`
+	Operator  string `json:"operator"`
+	Threshold int    `json:"threshold"`
+	Value     int    `json:"value"`
+}
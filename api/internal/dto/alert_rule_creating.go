@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AlertRuleCreating struct {
+	DirectionID uint `json:"direction_id" validate:"required"`
+	AlertRuleUpdating
+}
+
+func (d *AlertRuleCreating) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("error while validating alert rule: %w", err)
+	}
+
+	return d.AlertRuleUpdating.Validate(validate)
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const alertMetricValidation = "required,oneof=" + PositionMetric + " " + PositionChangeMetric + " " +
+	SubmittedConsentUpperMetric + " " + PriorityOneUpperMetric + " " + WithinBudgetMetric
+
+// AlertRuleUpdating describes condition of alert rule. Cooldown is a minimal
+// number of seconds between two alerts of the rule.
+type AlertRuleUpdating struct {
+	Metric    string `json:"metric"`
+	Operator  string `json:"operator" validate:"required,oneof=gt gte lt lte eq ne"`
+	Threshold int    `json:"threshold"`
+	Cooldown  int    `json:"cooldown" validate:"min=0,max=2592000"`
+}
+
+func (d *AlertRuleUpdating) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("error while validating alert rule: %w", err)
+	}
+
+	if err := validate.Var(d.Metric, alertMetricValidation); err != nil {
+		return fmt.Errorf("error while validating alert rule metric: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// AuditLogQuery filters audit log records by query parameters, empty parameters don't filter records.
+type AuditLogQuery struct {
+	UserID *uint      `form:"user_id"`
+	Action string     `form:"action" validate:"max=64"`
+	From   *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To     *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit  int        `form:"limit" validate:"min=0,max=500"`
+	Offset int        `form:"offset" validate:"min=0"`
+}
+
+func (d *AuditLogQuery) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
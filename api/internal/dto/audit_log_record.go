@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type AuditLogRecord struct {
+	ID        uint              `json:"id"`
+	UserID    *uint             `json:"user_id"`
+	Action    string            `json:"action"`
+	IP        string            `json:"ip"`
+	UserAgent string            `json:"user_agent"`
+	RequestID string            `json:"request_id"`
+	Metadata  map[string]string `json:"metadata"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+func NewAuditLogRecord(r models.AuditLogRecord) AuditLogRecord {
+	record := AuditLogRecord{
+		ID:        r.ID,
+		Action:    r.Action,
+		IP:        r.IP,
+		UserAgent: r.UserAgent,
+		RequestID: r.RequestID,
+		Metadata:  map[string]string{},
+		CreatedAt: r.CreatedAt,
+	}
+
+	if r.UserID.Valid {
+		userID := uint(r.UserID.Int64)
+		record.UserID = &userID
+	}
+
+	_ = json.Unmarshal([]byte(r.Metadata), &record.Metadata)
+
+	return record
+}
@@ -4,3 +4,10 @@ type AuthorizationTokens struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
+
+func NewAuthorizationTokens(accessToken string, refreshToken string) *AuthorizationTokens {
+	return &AuthorizationTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+}
@@ -0,0 +1,6 @@
+package dto
+
+// BackupCodes are one-time codes replacing TOTP codes, they are shown only once.
+type BackupCodes struct {
+	BackupCodes []string `json:"backup_codes"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type CachePurged struct {
+	Deleted int64 `json:"deleted"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+// ClientInfo describes device which signs in, it is shown in the list of user sessions.
+// RequestID ties audit log records to the request which caused them.
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type DirectionPatching struct {
+	Name *string `json:"name" validate:"omitempty,min=1,max=255"`
+	URL  *string `json:"url" validate:"omitempty,url,max=255"`
+}
+
+func (d *DirectionPatching) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
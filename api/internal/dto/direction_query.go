@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DirectionQuery filters directions catalogue by query parameters, empty parameters don't filter directions.
+// Cursor is returned with previous page, so the next page continues after it.
+type DirectionQuery struct {
+	UniversityID *uint  `form:"university_id"`
+	Search       string `form:"search" validate:"max=255"`
+	Code         string `form:"code" validate:"max=32"`
+	Cursor       string `form:"cursor" validate:"max=32"`
+	Limit        int    `form:"limit" validate:"min=0,max=200"`
+}
+
+func (d *DirectionQuery) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
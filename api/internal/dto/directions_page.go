@@ -0,0 +1,6 @@
+package dto
+
+type DirectionsPage struct {
+	Directions []UniversityDirection `json:"directions"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type EmailAddress struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+func (d *EmailAddress) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("error while validating email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type EmailSettings struct {
+	Language           *string `json:"language" validate:"omitempty,oneof=ru en"`
+	EmailNotifications *bool   `json:"email_notifications"`
+}
+
+func (d *EmailSettings) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("error while validating email settings: %w", err)
+	}
+
+	return nil
+}
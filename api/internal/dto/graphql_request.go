@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query" validate:"required,max=10000"`
+	OperationName string                 `json:"operationName" validate:"max=128"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (d *GraphQLRequest) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
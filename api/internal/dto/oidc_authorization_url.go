@@ -0,0 +1,5 @@
+package dto
+
+type OIDCAuthorizationURL struct {
+	URL string `json:"url"`
+}
@@ -1,11 +1,17 @@
 package dto
 
 type ParsingResult struct {
-	Position              uint
-	Score                 uint
-	PriorityOneUpper      uint
-	SubmittedConsentUpper uint
-	BudgetPlaces          uint
+	Position              uint `json:"position"`
+	Score                 uint `json:"score"`
+	PriorityOneUpper      uint `json:"priority_one_upper"`
+	SubmittedConsentUpper uint `json:"submitted_consent_upper"`
+	BudgetPlaces          uint `json:"budget_places"`
 }
 
 var EmptyParsingResult ParsingResult
+
+// IsWithinBudget reports whether there are fewer applicants with submitted consent
+// above the user than budget places. It is always false when budget places are unknown.
+func (r ParsingResult) IsWithinBudget() bool {
+	return r.BudgetPlaces != 0 && r.SubmittedConsentUpper < r.BudgetPlaces
+}
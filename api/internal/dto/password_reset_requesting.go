@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type PasswordResetRequesting struct {
+	Username string `json:"username" validate:"required,min=4,max=10"`
+}
+
+func (d *PasswordResetRequesting) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type PersonalAccessToken struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	RateLimit  int        `json:"rate_limit"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func NewPersonalAccessToken(t models.PersonalAccessToken) PersonalAccessToken {
+	token := PersonalAccessToken{
+		ID:        t.ID,
+		Name:      t.Name,
+		Scopes:    strings.Fields(t.Scopes),
+		RateLimit: t.RateLimit,
+		CreatedAt: t.CreatedAt,
+	}
+
+	if t.LastUsedAt.Valid {
+		token.LastUsedAt = &t.LastUsedAt.Time
+	}
+
+	if t.ExpiresAt.Valid {
+		token.ExpiresAt = &t.ExpiresAt.Time
+	}
+
+	return token
+}
+
+// CreatedPersonalAccessToken is created token with its value, which is shown only once.
+type CreatedPersonalAccessToken struct {
+	PersonalAccessToken
+	Token string `json:"token"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+)
+
+// PersonalAccessTokenCreating is personal access token parameters. Token without expiration
+// time lives until it is revoked, rate limit is count of requests per configured window.
+type PersonalAccessTokenCreating struct {
+	Name      string     `json:"name" validate:"required,max=64"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,unique"`
+	RateLimit *int       `json:"rate_limit" validate:"omitempty,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (d *PersonalAccessTokenCreating) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	for _, scope := range d.Scopes {
+		if !authorization.IsScope(scope) {
+			return fmt.Errorf("unknown scope: %s", scope)
+		}
+	}
+
+	if d.ExpiresAt != nil && d.ExpiresAt.Before(time.Now()) {
+		return errors.New("expiration time must be in future")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+const (
+	RatingChangedEvent  = "rating.changed"
+	AlertTriggeredEvent = "alert.triggered"
+)
+
+const (
+	PositionChange              = "position"
+	SubmittedConsentUpperChange = "submitted_consent_upper"
+	BudgetStatusChange          = "budget_status"
+)
+
+type RatingEventDirection struct {
+	ID             uint   `json:"id"`
+	Name           string `json:"name"`
+	UniversityID   uint   `json:"university_id"`
+	UniversityName string `json:"university_name"`
+}
+
+type RatingState struct {
+	ParsingResult
+	IsWithinBudget bool `json:"is_within_budget"`
+}
+
+func NewRatingState(r ParsingResult) RatingState {
+	return RatingState{
+		ParsingResult:  r,
+		IsWithinBudget: r.IsWithinBudget(),
+	}
+}
+
+type RatingEvent struct {
+	ID         string               `json:"id"`
+	Type       string               `json:"type"`
+	OccurredAt time.Time            `json:"occurred_at"`
+	UserID     uint                 `json:"user_id"`
+	Direction  RatingEventDirection `json:"direction"`
+	Changes    []string             `json:"changes"`
+	Previous   RatingState          `json:"previous"`
+	Current    RatingState          `json:"current"`
+	Alerts     []TriggeredAlert     `json:"alerts,omitempty"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type RatingHistoryRecord struct {
+	RatingState
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewRatingHistoryRecord(r models.RatingHistory) RatingHistoryRecord {
+	return RatingHistoryRecord{
+		RatingState: NewRatingState(ParsingResult{
+			Position:              r.Position,
+			Score:                 r.Score,
+			PriorityOneUpper:      r.PriorityOneUpper,
+			SubmittedConsentUpper: r.SubmittedConsentUpper,
+			BudgetPlaces:          r.BudgetPlaces,
+		}),
+		CreatedAt: r.CreatedAt,
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type RoleSetting struct {
+	Role string `json:"role" validate:"required,oneof=student counselor admin"`
+}
+
+func (d *RoleSetting) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type Session struct {
+	ID         string    `json:"id"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"`
+}
+
+func NewSession(session models.Session, currentSessionID string) Session {
+	return Session{
+		ID:         session.ID,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		Current:    session.ID == currentSessionID,
+	}
+}
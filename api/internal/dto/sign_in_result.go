@@ -0,0 +1,7 @@
+package dto
+
+// SignInResult is result of the first sign in step: either tokens or two-factor challenge.
+type SignInResult struct {
+	Tokens    *AuthorizationTokens
+	Challenge *TwoFactorChallenge
+}
@@ -0,0 +1,7 @@
+package dto
+
+type TelegramLinkCode struct {
+	Code      string `json:"code"`
+	ExpiresIn int    `json:"expires_in"`
+	URL       string `json:"url,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// TOTPEnrollment is secret of not confirmed TOTP enrollment. ProvisioningURI is encoded
+// to QR code for authenticator apps, secret can be entered manually.
+type TOTPEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
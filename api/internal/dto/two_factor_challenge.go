@@ -0,0 +1,8 @@
+package dto
+
+// TwoFactorChallenge is returned by sign in instead of tokens if user has two-factor
+// authentication enabled. ExpiresIn is lifetime of challenge token in seconds.
+type TwoFactorChallenge struct {
+	ChallengeToken string `json:"challenge_token"`
+	ExpiresIn      int    `json:"expires_in"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TwoFactorCode is TOTP code or backup code.
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required,max=32"`
+}
+
+func (d *TwoFactorCode) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
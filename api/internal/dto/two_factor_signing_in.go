@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type TwoFactorSigningIn struct {
+	ChallengeToken string `json:"challenge_token" validate:"required,max=128"`
+	Code           string `json:"code" validate:"required,max=32"`
+}
+
+func (d *TwoFactorSigningIn) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
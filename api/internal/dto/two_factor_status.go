@@ -0,0 +1,6 @@
+package dto
+
+type TwoFactorStatus struct {
+	Enabled         bool `json:"enabled"`
+	BackupCodesLeft int  `json:"backup_codes_left"`
+}
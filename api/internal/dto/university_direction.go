@@ -0,0 +1,21 @@
+package dto
+
+import "github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+
+type UniversityDirection struct {
+	ID                 uint   `json:"id"`
+	Name               string `json:"name"`
+	UniversityID       uint   `json:"university_id"`
+	UniversityName     string `json:"university_name"`
+	UniversityFullName string `json:"university_full_name"`
+}
+
+func NewUniversityDirection(d rdto.Direction) UniversityDirection {
+	return UniversityDirection{
+		ID:                 d.DirectionID,
+		Name:               d.DirectionName,
+		UniversityID:       d.UniversityID,
+		UniversityName:     d.UniversityName,
+		UniversityFullName: d.UniversityFullName,
+	}
+}
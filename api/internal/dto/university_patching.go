@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type UniversityPatching struct {
+	Name              *string `json:"name" validate:"omitempty,min=1,max=255"`
+	FullName          *string `json:"full_name" validate:"omitempty,min=1,max=255"`
+	DirectionsPageURL *string `json:"directions_page_url" validate:"omitempty,url,max=255"`
+}
+
+func (d *UniversityPatching) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	return nil
+}
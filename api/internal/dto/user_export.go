@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+// UserExport is all personal data of user, which is returned by export.
+type UserExport struct {
+	Profile       UserProfile                   `json:"profile"`
+	Universities  []University                  `json:"universities"`
+	Directions    []UniversityDirections        `json:"directions"`
+	RatingHistory []ExportedRatingHistoryRecord `json:"rating_history"`
+	AlertRules    []AlertRule                   `json:"alert_rules"`
+	Webhooks      []Webhook                     `json:"webhooks"`
+	Identities    []UserIdentity                `json:"identities"`
+	Sessions      []Session                     `json:"sessions"`
+	AuditLog      []AuditLogRecord              `json:"audit_log"`
+	ExportedAt    time.Time                     `json:"exported_at"`
+}
+
+type ExportedRatingHistoryRecord struct {
+	DirectionID uint `json:"direction_id"`
+	RatingHistoryRecord
+}
+
+func NewExportedRatingHistoryRecord(r models.RatingHistory) ExportedRatingHistoryRecord {
+	return ExportedRatingHistoryRecord{
+		DirectionID:         r.DirectionID,
+		RatingHistoryRecord: NewRatingHistoryRecord(r),
+	}
+}
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type UserIdentity struct {
+	Provider  string    `json:"provider"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewUserIdentity(identity models.UserIdentity) UserIdentity {
+	return UserIdentity{
+		Provider:  identity.Provider,
+		Email:     identity.Email.String,
+		CreatedAt: identity.CreatedAt,
+	}
+}
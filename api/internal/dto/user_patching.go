@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/validation"
+)
+
+type UserPatching struct {
+	FirstName  *string `json:"first_name" validate:"omitempty,alpha,min=3"`
+	MiddleName *string `json:"middle_name" validate:"omitempty,alpha,min=3"`
+	LastName   *string `json:"last_name" validate:"omitempty,alpha,min=3"`
+	Snils      *string `json:"snils" validate:"omitempty,numeric,len=11"`
+}
+
+func (d *UserPatching) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("failed to validate dto: %w", err)
+	}
+
+	if d.Snils == nil {
+		return nil
+	}
+
+	if err := validation.Snils(*d.Snils); err != nil {
+		return fmt.Errorf("invalid snils: %w", err)
+	}
+
+	return nil
+}
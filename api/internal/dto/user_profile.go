@@ -1,9 +1,15 @@
 package dto
 
 type UserProfile struct {
-	Username   string `json:"username"`
-	FirstName  string `json:"first_name"`
-	MiddleName string `json:"middle_name"`
-	LastName   string `json:"last_name"`
-	Snils      string `json:"snils"`
+	Username           string `json:"username"`
+	FirstName          string `json:"first_name"`
+	MiddleName         string `json:"middle_name"`
+	LastName           string `json:"last_name"`
+	Snils              string `json:"snils"`
+	Email              string `json:"email"`
+	IsEmailVerified    bool   `json:"is_email_verified"`
+	EmailNotifications bool   `json:"email_notifications"`
+	Language           string `json:"language"`
+	IsTelegramLinked   bool   `json:"is_telegram_linked"`
+	IsSnilsRequired    bool   `json:"is_snils_required"`
 }
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type Webhook struct {
+	ID        uint      `json:"id"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WebhookCreated struct {
+	Webhook
+	Secret string `json:"secret"`
+}
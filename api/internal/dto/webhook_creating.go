@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type WebhookCreating struct {
+	URL string `json:"url" validate:"required,url,max=2048"`
+}
+
+func (d *WebhookCreating) Validate(validate *validator.Validate) error {
+	if err := validate.Struct(d); err != nil {
+		return fmt.Errorf("error while validating webhook: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+)
+
+type WebhookDelivery struct {
+	ID               uint       `json:"id"`
+	EventID          string     `json:"event_id"`
+	EventType        string     `json:"event_type"`
+	Status           string     `json:"status"`
+	Attempts         int        `json:"attempts"`
+	NextAttemptAt    time.Time  `json:"next_attempt_at"`
+	LastResponseCode *int       `json:"last_response_code"`
+	LastError        *string    `json:"last_error"`
+	CreatedAt        time.Time  `json:"created_at"`
+	DeliveredAt      *time.Time `json:"delivered_at"`
+}
+
+func NewWebhookDelivery(d models.WebhookDelivery) WebhookDelivery {
+	delivery := WebhookDelivery{
+		ID:            d.ID,
+		EventID:       d.EventID,
+		EventType:     d.EventType,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+	}
+
+	if d.LastResponseCode.Valid {
+		code := int(d.LastResponseCode.Int32)
+		delivery.LastResponseCode = &code
+	}
+
+	if d.LastError.Valid {
+		delivery.LastError = &d.LastError.String
+	}
+
+	if d.DeliveredAt.Valid {
+		delivery.DeliveredAt = &d.DeliveredAt.Time
+	}
+
+	return delivery
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+type AlertRule struct {
+	ID              uint         `json:"id" db:"id"`
+	UserID          uint         `json:"user_id" db:"user_id"`
+	DirectionID     uint         `json:"direction_id" db:"direction_id"`
+	Metric          string       `json:"metric" db:"metric"`
+	Operator        string       `json:"operator" db:"operator"`
+	Threshold       int          `json:"threshold" db:"threshold"`
+	Cooldown        int          `json:"cooldown" db:"cooldown"`
+	LastTriggeredAt sql.NullTime `json:"last_triggered_at" db:"last_triggered_at"`
+	CreatedAt       time.Time    `json:"created_at" db:"created_at"`
+}
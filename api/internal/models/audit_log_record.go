@@ -0,0 +1,19 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AuditLogRecord is the event of user account. Records aren't bound to users by foreign
+// key, so they outlive deleted accounts.
+type AuditLogRecord struct {
+	ID        uint          `json:"id" db:"id"`
+	UserID    sql.NullInt64 `json:"user_id" db:"user_id"`
+	Action    string        `json:"action" db:"action"`
+	IP        string        `json:"ip" db:"ip"`
+	UserAgent string        `json:"user_agent" db:"user_agent"`
+	RequestID string        `json:"request_id" db:"request_id"`
+	Metadata  string        `json:"metadata" db:"metadata"`
+	CreatedAt time.Time     `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// OIDCState is the state of OpenID Connect authorization started by user. UserID is set
+// when identity is linked to signed in user instead of signing in.
+type OIDCState struct {
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	UserID       uint
+}
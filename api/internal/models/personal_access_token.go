@@ -0,0 +1,20 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// PersonalAccessToken is long-lived token of user, only hash of token is stored.
+// Scopes are separated by space.
+type PersonalAccessToken struct {
+	ID         uint         `json:"id" db:"id"`
+	UserID     uint         `json:"user_id" db:"user_id"`
+	Name       string       `json:"name" db:"name"`
+	TokenHash  string       `json:"token_hash" db:"token_hash"`
+	Scopes     string       `json:"scopes" db:"scopes"`
+	RateLimit  int          `json:"rate_limit" db:"rate_limit"`
+	LastUsedAt sql.NullTime `json:"last_used_at" db:"last_used_at"`
+	ExpiresAt  sql.NullTime `json:"expires_at" db:"expires_at"`
+	CreatedAt  time.Time    `json:"created_at" db:"created_at"`
+}
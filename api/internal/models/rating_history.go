@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type RatingHistory struct {
+	ID                    uint      `json:"id" db:"id"`
+	UserID                uint      `json:"user_id" db:"user_id"`
+	DirectionID           uint      `json:"direction_id" db:"direction_id"`
+	Position              uint      `json:"position" db:"position"`
+	Score                 uint      `json:"score" db:"score"`
+	PriorityOneUpper      uint      `json:"priority_one_upper" db:"priority_one_upper"`
+	SubmittedConsentUpper uint      `json:"submitted_consent_upper" db:"submitted_consent_upper"`
+	BudgetPlaces          uint      `json:"budget_places" db:"budget_places"`
+	CreatedAt             time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Session is the sign in of user on particular device. It is prolonged by every
+// refresh of tokens, which rotates its refresh token id. Id of the latest access token
+// is kept to revoke it together with session.
+type Session struct {
+	ID                   string
+	UserID               uint
+	RefreshTokenID       string
+	AccessTokenID        string
+	AccessTokenExpiresAt time.Time
+	IP                   string
+	UserAgent            string
+	CreatedAt            time.Time
+	LastUsedAt           time.Time
+}
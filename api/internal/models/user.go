@@ -1,11 +1,21 @@
 package models
 
+import "database/sql"
+
 type User struct {
-	ID         uint   `json:"id" db:"id"`
-	Username   string `json:"username" db:"username"`
-	Password   string `json:"password" db:"password"`
-	FirstName  string `json:"first_name" db:"first_name"`
-	MiddleName string `json:"middle_name" db:"middle_name"`
-	LastName   string `json:"last_name" db:"last_name"`
-	Snils      string `json:"snils" db:"snils"`
+	ID                 uint           `json:"id" db:"id"`
+	Username           string         `json:"username" db:"username"`
+	Password           string         `json:"password" db:"password"`
+	FirstName          string         `json:"first_name" db:"first_name"`
+	MiddleName         string         `json:"middle_name" db:"middle_name"`
+	LastName           string         `json:"last_name" db:"last_name"`
+	Snils              string         `json:"snils" db:"snils"`
+	SnilsHash          sql.NullString `json:"snils_hash" db:"snils_hash"`
+	SnilsMask          string         `json:"snils_mask" db:"snils_mask"`
+	Email              sql.NullString `json:"email" db:"email"`
+	IsEmailVerified    bool           `json:"is_email_verified" db:"is_email_verified"`
+	EmailNotifications bool           `json:"email_notifications" db:"email_notifications"`
+	Language           string         `json:"language" db:"language"`
+	TelegramChatID     sql.NullInt64  `json:"telegram_chat_id" db:"telegram_chat_id"`
+	Role               string         `json:"role" db:"role"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserIdentity is the account of user in external OpenID Connect provider.
+type UserIdentity struct {
+	ID        uint           `json:"id" db:"id"`
+	UserID    uint           `json:"user_id" db:"user_id"`
+	Provider  string         `json:"provider" db:"provider"`
+	Subject   string         `json:"subject" db:"subject"`
+	Email     sql.NullString `json:"email" db:"email"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// UserTOTP is TOTP secret of user encrypted at rest. Secret is enabled only after user
+// confirms it by code, so unfinished enrollment doesn't affect sign in.
+type UserTOTP struct {
+	UserID    uint      `json:"user_id" db:"user_id"`
+	Secret    string    `json:"secret" db:"secret"`
+	Enabled   bool      `json:"enabled" db:"enabled"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
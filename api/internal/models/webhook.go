@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Webhook struct {
+	ID        uint      `json:"id" db:"id"`
+	UserID    uint      `json:"user_id" db:"user_id"`
+	URL       string    `json:"url" db:"url"`
+	Secret    string    `json:"secret" db:"secret"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
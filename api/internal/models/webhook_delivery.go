@@ -0,0 +1,27 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type WebhookDelivery struct {
+	ID               uint           `json:"id" db:"id"`
+	WebhookID        uint           `json:"webhook_id" db:"webhook_id"`
+	EventID          string         `json:"event_id" db:"event_id"`
+	EventType        string         `json:"event_type" db:"event_type"`
+	Payload          string         `json:"payload" db:"payload"`
+	Status           string         `json:"status" db:"status"`
+	Attempts         int            `json:"attempts" db:"attempts"`
+	NextAttemptAt    time.Time      `json:"next_attempt_at" db:"next_attempt_at"`
+	LastResponseCode sql.NullInt32  `json:"last_response_code" db:"last_response_code"`
+	LastError        sql.NullString `json:"last_error" db:"last_error"`
+	CreatedAt        time.Time      `json:"created_at" db:"created_at"`
+	DeliveredAt      sql.NullTime   `json:"delivered_at" db:"delivered_at"`
+}
@@ -13,6 +13,9 @@ func (e *DBError) Error() string {
 }
 
 var (
-	ErrRecordNotFound    = NewDBError("record not found")
-	ErrUserAlreadyExists = NewDBError("user already exists")
+	ErrRecordNotFound        = NewDBError("record not found")
+	ErrUserAlreadyExists     = NewDBError("user already exists")
+	ErrEmailAlreadyUsed      = NewDBError("email is already used")
+	ErrSnilsAlreadyUsed      = NewDBError("snils is already used")
+	ErrIdentityAlreadyLinked = NewDBError("identity is already linked")
 )
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type AlertRuleImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewAlertRuleImpl(db *sqlx.DB) *AlertRuleImpl {
+	return &AlertRuleImpl{
+		db:     db,
+		logger: logging.NewLogger("alert rule repository"),
+	}
+}
+
+func (r *AlertRuleImpl) Create(ctx context.Context, rule rdto.AlertRuleCreating) (uint, error) {
+	var id uint
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, direction_id, metric, operator, threshold, cooldown)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		alertRulesTable,
+	)
+	if err := r.db.QueryRowContext(
+		ctx, query, rule.UserID, rule.DirectionID, rule.Metric, rule.Operator, rule.Threshold, rule.Cooldown,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("error while creating alert rule: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *AlertRuleImpl) GetByID(ctx context.Context, userID uint, id uint) (*models.AlertRule, error) {
+	var rule models.AlertRule
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id = $1 AND user_id = $2", alertRulesTable)
+	if err := r.db.GetContext(ctx, &rule, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting alert rule: %w", err)
+	}
+
+	return &rule, nil
+}
+
+func (r *AlertRuleImpl) GetForUser(ctx context.Context, userID uint) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE user_id = $1 ORDER BY id", alertRulesTable)
+	if err := r.db.SelectContext(ctx, &rules, query, userID); err != nil {
+		return nil, fmt.Errorf("error while getting user alert rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *AlertRuleImpl) GetForDirection(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE user_id = $1 AND direction_id = $2 ORDER BY id", alertRulesTable)
+	if err := r.db.SelectContext(ctx, &rules, query, userID, directionID); err != nil {
+		return nil, fmt.Errorf("error while getting direction alert rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *AlertRuleImpl) Update(ctx context.Context, userID uint, id uint, rule rdto.AlertRuleUpdating) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET metric = $1, operator = $2, threshold = $3, cooldown = $4, last_triggered_at = NULL
+		WHERE id = $5 AND user_id = $6`,
+		alertRulesTable,
+	)
+
+	result, err := r.db.ExecContext(ctx, query, rule.Metric, rule.Operator, rule.Threshold, rule.Cooldown, id, userID)
+	if err != nil {
+		return fmt.Errorf("error while updating alert rule: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *AlertRuleImpl) Delete(ctx context.Context, userID uint, id uint) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND user_id = $2", alertRulesTable)
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("error while deleting alert rule: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// MarkTriggered sets rule trigger time unless rule is on cooldown and reports
+// whether it was set, so concurrently evaluated rule triggers only once.
+func (r *AlertRuleImpl) MarkTriggered(ctx context.Context, id uint) (bool, error) {
+	query := fmt.Sprintf(
+		`UPDATE %s SET last_triggered_at = now()
+		WHERE id = $1 AND (last_triggered_at IS NULL OR last_triggered_at + cooldown * interval '1 second' <= now())`,
+		alertRulesTable,
+	)
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("error while marking alert rule triggered: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error while getting marked alert rules count: %w", err)
+	}
+
+	return n != 0, nil
+}
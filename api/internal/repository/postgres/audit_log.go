@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type AuditLogImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewAuditLogImpl(db *sqlx.DB) *AuditLogImpl {
+	return &AuditLogImpl{
+		db:     db,
+		logger: logging.NewLogger("audit log repository"),
+	}
+}
+
+func (r *AuditLogImpl) Create(ctx context.Context, record rdto.AuditLogCreating) error {
+	metadata := record.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("error while marshaling audit log metadata: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, action, ip, user_agent, request_id, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		auditLogTable,
+	)
+	if _, err := r.db.ExecContext(
+		ctx, query, newNullUserID(record.UserID), record.Action, record.IP, record.UserAgent, record.RequestID,
+		string(metadataJSON),
+	); err != nil {
+		return fmt.Errorf("error while creating audit log record: %w", err)
+	}
+
+	return nil
+}
+
+// GetForUser returns the latest records of user.
+func (r *AuditLogImpl) GetForUser(ctx context.Context, userID uint, limit int) ([]models.AuditLogRecord, error) {
+	var records []models.AuditLogRecord
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2", auditLogTable,
+	)
+	if err := r.db.SelectContext(ctx, &records, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("error while getting user audit log records: %w", err)
+	}
+
+	return records, nil
+}
+
+// Find returns records matching filter, the latest records go first.
+func (r *AuditLogImpl) Find(ctx context.Context, filter rdto.AuditLogFilter) ([]models.AuditLogRecord, error) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	if filter.UserID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id=$%d", argID))
+		args = append(args, *filter.UserID)
+		argID++
+	}
+
+	if filter.Action != "" {
+		conditions = append(conditions, fmt.Sprintf("action=$%d", argID))
+		args = append(args, filter.Action)
+		argID++
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at>=$%d", argID))
+		args = append(args, *filter.From)
+		argID++
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at<$%d", argID))
+		args = append(args, *filter.To)
+		argID++
+	}
+
+	where := ""
+	if len(conditions) != 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s %s ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d",
+		auditLogTable, where, argID, argID+1,
+	)
+	args = append(args, filter.Limit, filter.Offset)
+
+	var records []models.AuditLogRecord
+	if err := r.db.SelectContext(ctx, &records, query, args...); err != nil {
+		return nil, fmt.Errorf("error while finding audit log records: %w", err)
+	}
+
+	return records, nil
+}
+
+func newNullUserID(userID uint) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(userID), Valid: userID != 0}
+}
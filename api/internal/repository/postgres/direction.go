@@ -1,12 +1,17 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 )
@@ -23,48 +28,99 @@ func NewDirectionImpl(db *sqlx.DB) *DirectionImpl {
 	}
 }
 
-func (r *DirectionImpl) GetAll() ([]rdto.Direction, error) {
-	var directions []rdto.Direction
+// Find selects directions by filter. Name is searched case-insensitively by substring (trigram index),
+// specialty code is the first word of direction name, e.g. "09.03.01" or "СВ.5163.2021".
+func (r *DirectionImpl) Find(ctx context.Context, filter rdto.DirectionFilter) ([]rdto.Direction, error) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	if filter.UniversityID != nil {
+		conditions = append(conditions, fmt.Sprintf("d.university_id=$%d", argID))
+		args = append(args, *filter.UniversityID)
+		argID++
+	}
+
+	if filter.Search != "" {
+		conditions = append(conditions, fmt.Sprintf("d.name ILIKE $%d", argID))
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		argID++
+	}
+
+	if filter.CodePrefix != "" {
+		conditions = append(conditions, fmt.Sprintf("split_part(d.name, ' ', 1) LIKE $%d", argID))
+		args = append(args, escapeLike(filter.CodePrefix)+"%")
+		argID++
+	}
+
+	if filter.AfterID != 0 {
+		conditions = append(conditions, fmt.Sprintf("d.id>$%d", argID))
+		args = append(args, filter.AfterID)
+		argID++
+	}
+
+	where := ""
+	if len(conditions) != 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	limit := ""
+	if filter.Limit != 0 {
+		limit = fmt.Sprintf("LIMIT $%d", argID)
+		args = append(args, filter.Limit)
+	}
 
 	query := fmt.Sprintf(
-		`SELECT d.id as direction_id, d.name as direction_name, 
-			un.id as university_id, un.name as university_name, un.full_name as university_full_name FROM %s d 
-			INNER JOIN %s un on d.university_id = un.id`,
-		directionsTable, universitiesTable,
+		`SELECT d.id as direction_id, d.name as direction_name, d.url as direction_url,
+				un.id as university_id, un.name as university_name, un.full_name as university_full_name FROM %s d 
+			INNER JOIN %s un on d.university_id = un.id
+			%s ORDER BY d.id %s`,
+		directionsTable, universitiesTable, where, limit,
 	)
-	if err := r.db.Select(&directions, query); err != nil {
-		return nil, fmt.Errorf("error while getting all directions: %w", err)
+
+	var directions []rdto.Direction
+	if err := r.db.SelectContext(ctx, &directions, query, args...); err != nil {
+		return nil, fmt.Errorf("error while finding directions: %w", err)
 	}
 
 	return directions, nil
 }
 
-func (r *DirectionImpl) GetByID(id uint) (*models.Direction, error) {
+// escapeLike escapes LIKE pattern characters, so value is matched literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func (r *DirectionImpl) GetByID(ctx context.Context, id uint) (*models.Direction, error) {
 	var direction models.Direction
 
 	query := fmt.Sprintf(`SELECT * FROM %s d WHERE d.id = $1`, directionsTable)
-	if err := r.db.Get(&direction, query, id); err != nil {
+	if err := r.db.GetContext(ctx, &direction, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
 		return nil, fmt.Errorf("error while getting direction by id: %w", err)
 	}
 
 	return &direction, nil
 }
 
-func (r *DirectionImpl) GetUniversityID(id uint) (*rdto.UniversityID, error) {
+func (r *DirectionImpl) GetUniversityID(ctx context.Context, id uint) (*rdto.UniversityID, error) {
 	var universityID rdto.UniversityID
 
 	query := fmt.Sprintf(
 		`SELECT un.id as university_id FROM %s d INNER JOIN %s un on d.university_id = un.id WHERE d.id = $1`,
 		directionsTable, universitiesTable,
 	)
-	if err := r.db.Get(&universityID, query, id); err != nil {
+	if err := r.db.GetContext(ctx, &universityID, query, id); err != nil {
 		return nil, fmt.Errorf("error while getting university by id: %w", err)
 	}
 
 	return &universityID, nil
 }
 
-func (r *DirectionImpl) GetForUser(userID uint) ([]rdto.Direction, error) {
+func (r *DirectionImpl) GetForUser(ctx context.Context, userID uint) ([]rdto.Direction, error) {
 	var directions []rdto.Direction
 
 	query := fmt.Sprintf(
@@ -75,36 +131,40 @@ func (r *DirectionImpl) GetForUser(userID uint) ([]rdto.Direction, error) {
 			WHERE ud.user_id = $1`,
 		directionsTable, usersDirectionsTable, universitiesTable,
 	)
-	if err := r.db.Select(&directions, query, userID); err != nil {
+	if err := r.db.SelectContext(ctx, &directions, query, userID); err != nil {
 		return nil, fmt.Errorf("error while getting user directions: %w", err)
 	}
 
 	return directions, nil
 }
 
-func (r *DirectionImpl) SetForUser(userID uint, directionIDs dto.IDs) error {
-	tx, err := r.db.Begin()
+func (r *DirectionImpl) SetForUser(ctx context.Context, userID uint, directionIDs dto.IDs) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		r.logger.Error(err)
+		r.logger.WithContext(ctx).Error(err)
 
 		return fmt.Errorf("error while beginning transaction: %w", err)
 	}
 
 	query := fmt.Sprintf("INSERT INTO %s (user_id, direction_id) VALUES ($1, $2)", usersDirectionsTable)
 	for _, directionID := range directionIDs.IDs {
-		if _, err := tx.Exec(query, userID, directionID); err != nil {
-			r.logger.Error(err)
+		if _, err := tx.ExecContext(ctx, query, userID, directionID); err != nil {
+			r.logger.WithContext(ctx).Error(err)
 
 			if err := tx.Rollback(); err != nil {
 				return fmt.Errorf("error while rollbacking transaction: %w", err)
 			}
 
+			if isForeignKeyViolation(err) {
+				return repository.ErrRecordNotFound
+			}
+
 			return fmt.Errorf("error while adding directions to user: %w", err)
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		r.logger.Error(err)
+		r.logger.WithContext(ctx).Error(err)
 
 		if err := tx.Rollback(); err != nil {
 			return fmt.Errorf("error while rollbacking transaction: %w", err)
@@ -116,11 +176,202 @@ func (r *DirectionImpl) SetForUser(userID uint, directionIDs dto.IDs) error {
 	return nil
 }
 
-func (r *DirectionImpl) Clear(userID uint) error {
+func (r *DirectionImpl) GetForUserByID(ctx context.Context, userID uint, directionID uint) (*rdto.Direction, error) {
+	var direction rdto.Direction
+
+	query := fmt.Sprintf(
+		`SELECT d.id as direction_id, d.name as direction_name, d.url as direction_url,
+				un.id as university_id, un.name as university_name, un.full_name as university_full_name FROM %s d 
+			INNER JOIN %s ud on d.id = ud.direction_id
+			INNER JOIN %s un on d.university_id = un.id
+			WHERE ud.user_id = $1 AND d.id = $2`,
+		directionsTable, usersDirectionsTable, universitiesTable,
+	)
+	if err := r.db.GetContext(ctx, &direction, query, userID, directionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting user direction: %w", err)
+	}
+
+	return &direction, nil
+}
+
+func (r *DirectionImpl) GetForUniversities(ctx context.Context, universityIDs []uint) ([]rdto.Direction, error) {
+	directions := make([]rdto.Direction, 0)
+	if len(universityIDs) == 0 {
+		return directions, nil
+	}
+
+	query, args, err := sqlx.In(fmt.Sprintf(
+		`SELECT d.id as direction_id, d.name as direction_name, d.url as direction_url,
+				un.id as university_id, un.name as university_name, un.full_name as university_full_name FROM %s d 
+			INNER JOIN %s un on d.university_id = un.id
+			WHERE un.id IN (?) ORDER BY d.id`,
+		directionsTable, universitiesTable,
+	), universityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error while building university directions query: %w", err)
+	}
+
+	if err := r.db.SelectContext(ctx, &directions, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("error while getting university directions: %w", err)
+	}
+
+	return directions, nil
+}
+
+// AddForUser adds direction to user and reports whether it wasn't added before.
+// University of direction is added to user in the same transaction.
+func (r *DirectionImpl) AddForUser(ctx context.Context, userID uint, directionID uint) (bool, error) {
+	var added bool
+
+	err := r.inTransaction(ctx, func(tx *sql.Tx) error {
+		query := fmt.Sprintf(
+			"INSERT INTO %s (user_id, direction_id) VALUES ($1, $2) ON CONFLICT (user_id, direction_id) DO NOTHING",
+			usersDirectionsTable,
+		)
+
+		result, err := tx.ExecContext(ctx, query, userID, directionID)
+		if err != nil {
+			return fmt.Errorf("error while adding direction to user: %w", err)
+		}
+
+		if n, _ := result.RowsAffected(); n == 0 {
+			return nil
+		}
+
+		added = true
+
+		return r.syncUniversities(ctx, tx, userID)
+	})
+
+	return added, err
+}
+
+// RemoveForUser removes direction from user, university is removed from user
+// in the same transaction if user doesn't track its other directions.
+func (r *DirectionImpl) RemoveForUser(ctx context.Context, userID uint, directionID uint) error {
+	return r.inTransaction(ctx, func(tx *sql.Tx) error {
+		query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1 AND direction_id = $2", usersDirectionsTable)
+
+		result, err := tx.ExecContext(ctx, query, userID, directionID)
+		if err != nil {
+			return fmt.Errorf("error while removing direction from user: %w", err)
+		}
+
+		if n, _ := result.RowsAffected(); n == 0 {
+			return repository.ErrRecordNotFound
+		}
+
+		return r.syncUniversities(ctx, tx, userID)
+	})
+}
+
+// syncUniversities sets universities of user to universities of directions tracked by user.
+func (r *DirectionImpl) syncUniversities(ctx context.Context, tx *sql.Tx, userID uint) error {
+	query := fmt.Sprintf(
+		`DELETE FROM %s uu WHERE uu.user_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM %s ud INNER JOIN %s d on ud.direction_id = d.id
+			WHERE ud.user_id = uu.user_id AND d.university_id = uu.university_id)`,
+		usersUniversitiesTable, usersDirectionsTable, directionsTable,
+	)
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("error while removing user universities without directions: %w", err)
+	}
+
+	query = fmt.Sprintf(
+		`INSERT INTO %s (user_id, university_id)
+			SELECT DISTINCT ud.user_id, d.university_id FROM %s ud INNER JOIN %s d on ud.direction_id = d.id
+			WHERE ud.user_id = $1
+			ON CONFLICT (user_id, university_id) DO NOTHING`,
+		usersUniversitiesTable, usersDirectionsTable, directionsTable,
+	)
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("error while adding user universities of directions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DirectionImpl) GetTrackingUserIDs(ctx context.Context) ([]uint, error) {
+	var userIDs []uint
+
+	query := fmt.Sprintf("SELECT DISTINCT user_id FROM %s ORDER BY user_id", usersDirectionsTable)
+	if err := r.db.SelectContext(ctx, &userIDs, query); err != nil {
+		return nil, fmt.Errorf("error while getting users tracking directions: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+func (r *DirectionImpl) Clear(ctx context.Context, userID uint) error {
 	query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", usersDirectionsTable)
-	if _, err := r.db.Exec(query, userID); err != nil {
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
 		return fmt.Errorf("error while deleting user directions: %w", err)
 	}
 
 	return nil
 }
+
+func (r *DirectionImpl) Patch(ctx context.Context, id uint, data rdto.DirectionPatching) error {
+	setValues := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	if data.Name != nil {
+		setValues = append(setValues, fmt.Sprintf("name=$%d", argID))
+		args = append(args, *data.Name)
+		argID++
+	}
+
+	if data.URL != nil {
+		setValues = append(setValues, fmt.Sprintf("url=$%d", argID))
+		args = append(args, *data.URL)
+		argID++
+	}
+
+	if len(setValues) == 0 {
+		return nil
+	}
+
+	setQuery := strings.Join(setValues, ", ")
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE id=$%d", directionsTable, setQuery, argID)
+
+	args = append(args, id)
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error while patching direction: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *DirectionImpl) inTransaction(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error while beginning transaction: %w", err)
+	}
+
+	if err := f(tx); err != nil {
+		r.logger.WithContext(ctx).Error(err)
+
+		if err := tx.Rollback(); err != nil {
+			return fmt.Errorf("error while rollbacking transaction: %w", err)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error while committing transaction: %w", err)
+	}
+
+	return nil
+}
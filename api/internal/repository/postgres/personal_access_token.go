@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type PersonalAccessTokenImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewPersonalAccessTokenImpl(db *sqlx.DB) *PersonalAccessTokenImpl {
+	return &PersonalAccessTokenImpl{
+		db:     db,
+		logger: logging.NewLogger("personal access token repository"),
+	}
+}
+
+func (r *PersonalAccessTokenImpl) Create(ctx context.Context, token rdto.PersonalAccessTokenCreating) (uint, error) {
+	var id uint
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, name, token_hash, scopes, rate_limit, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		personalAccessTokensTable,
+	)
+	if err := r.db.QueryRowContext(
+		ctx, query, token.UserID, token.Name, token.TokenHash, token.Scopes, token.RateLimit, token.ExpiresAt,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("error while creating personal access token: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PersonalAccessTokenImpl) GetByID(
+	ctx context.Context,
+	userID uint,
+	id uint,
+) (*models.PersonalAccessToken, error) {
+	var token models.PersonalAccessToken
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id = $1 AND user_id = $2", personalAccessTokensTable)
+	if err := r.db.GetContext(ctx, &token, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting personal access token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenImpl) GetForUser(ctx context.Context, userID uint) ([]models.PersonalAccessToken, error) {
+	var tokens []models.PersonalAccessToken
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE user_id = $1 ORDER BY id", personalAccessTokensTable)
+	if err := r.db.SelectContext(ctx, &tokens, query, userID); err != nil {
+		return nil, fmt.Errorf("error while getting user personal access tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// GetByHash returns token by its hash with role of token user.
+func (r *PersonalAccessTokenImpl) GetByHash(
+	ctx context.Context,
+	tokenHash string,
+) (*rdto.PersonalAccessTokenWithRole, error) {
+	var token rdto.PersonalAccessTokenWithRole
+
+	query := fmt.Sprintf(
+		"SELECT pt.*, ut.role FROM %s pt INNER JOIN %s ut ON ut.id = pt.user_id WHERE pt.token_hash = $1",
+		personalAccessTokensTable, usersTable,
+	)
+	if err := r.db.GetContext(ctx, &token, query, tokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting personal access token by hash: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenImpl) UpdateLastUsed(ctx context.Context, id uint, lastUsedAt time.Time) error {
+	query := fmt.Sprintf("UPDATE %s SET last_used_at = $1 WHERE id = $2", personalAccessTokensTable)
+	if _, err := r.db.ExecContext(ctx, query, lastUsedAt, id); err != nil {
+		return fmt.Errorf("error while updating personal access token last usage: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PersonalAccessTokenImpl) Delete(ctx context.Context, userID uint, id uint) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND user_id = $2", personalAccessTokensTable)
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("error while deleting personal access token: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -1,35 +1,77 @@
 package postgres
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
 
+	"github.com/XSAM/otelsql"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // postgres migrations driver
 	_ "github.com/golang-migrate/migrate/v4/source/file"       // for migrations
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq" // postgres database driver
+	"github.com/lib/pq" // postgres database driver
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
 )
 
 const (
-	usersTable             = "users"
-	universitiesTable      = "universities"
-	directionsTable        = "directions"
-	usersUniversitiesTable = "users_universities"
-	usersDirectionsTable   = "users_directions"
+	usersTable                = "users"
+	universitiesTable         = "universities"
+	directionsTable           = "directions"
+	usersUniversitiesTable    = "users_universities"
+	usersDirectionsTable      = "users_directions"
+	ratingsHistoryTable       = "ratings_history"
+	webhooksTable             = "webhooks"
+	webhookDeliveriesTable    = "webhook_deliveries"
+	alertRulesTable           = "alert_rules"
+	userIdentitiesTable       = "user_identities"
+	auditLogTable             = "audit_log"
+	personalAccessTokensTable = "personal_access_tokens"
+	userTOTPTable             = "user_totp"
+	totpBackupCodesTable      = "totp_backup_codes"
 )
 
+const (
+	uniqueViolation     = "unique_violation"
+	foreignKeyViolation = "foreign_key_violation"
+)
+
+// isUniqueViolation reports whether err is caused by violation of unique constraint,
+// e.g. by already taken username.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == uniqueViolation
+}
+
+// isForeignKeyViolation reports whether err is caused by reference to record which doesn't exist.
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == foreignKeyViolation
+}
+
+// NewDB opens database by driver instrumented by OpenTelemetry, so queries made with context
+// of traced request are recorded as its spans.
 func NewDB(cfg *config.DB) (*sqlx.DB, error) {
-	db, err := sqlx.Open(cfg.Driver, fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
+	driverName, err := otelsql.Register(cfg.Driver, semconv.DBSystemPostgreSQL.Value.AsString())
+	if err != nil {
+		return nil, fmt.Errorf("error occurred while registering traced db driver: %w", err)
+	}
+
+	sqlDB, err := sql.Open(driverName, fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
 		cfg.Host, cfg.Port, cfg.Username, cfg.DBName, cfg.Password, cfg.SSLMode),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error occurred while opening db connection: %w", err)
 	}
 
+	db := sqlx.NewDb(sqlDB, cfg.Driver)
+
 	err = db.Ping()
 	if err != nil {
 		return nil, fmt.Errorf("error occurred while pinging db: %w", err)
@@ -52,8 +94,16 @@ func NewDB(cfg *config.DB) (*sqlx.DB, error) {
 
 func NewRepository(db *sqlx.DB) *repository.Repository {
 	return &repository.Repository{
-		User:       NewUserImpl(db),
-		University: NewUniversityImpl(db),
-		Direction:  NewDirectionImpl(db),
+		User:                NewUserImpl(db),
+		University:          NewUniversityImpl(db),
+		Direction:           NewDirectionImpl(db),
+		RatingHistory:       NewRatingHistoryImpl(db),
+		Webhook:             NewWebhookImpl(db),
+		WebhookDelivery:     NewWebhookDeliveryImpl(db),
+		AlertRule:           NewAlertRuleImpl(db),
+		UserIdentity:        NewUserIdentityImpl(db),
+		AuditLog:            NewAuditLogImpl(db),
+		PersonalAccessToken: NewPersonalAccessTokenImpl(db),
+		TwoFactor:           NewTwoFactorImpl(db),
 	}
 }
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type RatingHistoryImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewRatingHistoryImpl(db *sqlx.DB) *RatingHistoryImpl {
+	return &RatingHistoryImpl{
+		db:     db,
+		logger: logging.NewLogger("rating history repository"),
+	}
+}
+
+// Create saves record and enqueues deliveries of events about it to every webhook of user
+// in one transaction, so events aren't lost if saving fails halfway.
+func (r *RatingHistoryImpl) Create(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+	result dto.ParsingResult,
+	events []rdto.WebhookEvent,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error while beginning transaction: %w", err)
+	}
+
+	if err := r.create(ctx, tx, userID, directionID, result, events); err != nil {
+		if err := tx.Rollback(); err != nil {
+			return fmt.Errorf("error while rollbacking transaction: %w", err)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error while committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RatingHistoryImpl) create(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID uint,
+	directionID uint,
+	result dto.ParsingResult,
+	events []rdto.WebhookEvent,
+) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, direction_id, position, score, priority_one_upper, submitted_consent_upper, budget_places)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		ratingsHistoryTable,
+	)
+	if _, err := tx.ExecContext(
+		ctx, query, userID, directionID,
+		result.Position, result.Score, result.PriorityOneUpper, result.SubmittedConsentUpper, result.BudgetPlaces,
+	); err != nil {
+		return fmt.Errorf("error while creating rating history record: %w", err)
+	}
+
+	query = fmt.Sprintf(
+		`INSERT INTO %s (webhook_id, event_id, event_type, payload)
+		SELECT w.id, $2, $3, $4 FROM %s w WHERE w.user_id = $1`,
+		webhookDeliveriesTable, webhooksTable,
+	)
+	for _, event := range events {
+		if _, err := tx.ExecContext(ctx, query, userID, event.ID, event.Type, event.Payload); err != nil {
+			return fmt.Errorf("error while creating webhook deliveries of event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *RatingHistoryImpl) GetLatest(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+) (*models.RatingHistory, error) {
+	var record models.RatingHistory
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE user_id = $1 AND direction_id = $2 ORDER BY created_at DESC, id DESC LIMIT 1",
+		ratingsHistoryTable,
+	)
+	if err := r.db.GetContext(ctx, &record, query, userID, directionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting latest rating history record: %w", err)
+	}
+
+	return &record, nil
+}
+
+func (r *RatingHistoryImpl) GetForDirection(
+	ctx context.Context, userID uint,
+	directionID uint,
+	limit int,
+) ([]models.RatingHistory, error) {
+	var records []models.RatingHistory
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE user_id = $1 AND direction_id = $2 ORDER BY created_at DESC, id DESC LIMIT $3",
+		ratingsHistoryTable,
+	)
+	if err := r.db.SelectContext(ctx, &records, query, userID, directionID, limit); err != nil {
+		return nil, fmt.Errorf("error while getting rating history records: %w", err)
+	}
+
+	return records, nil
+}
+
+// GetForDirections returns the latest records of every direction, at most limit records per direction.
+func (r *RatingHistoryImpl) GetForDirections(
+	ctx context.Context, userID uint,
+	directionIDs []uint,
+	limit int,
+) ([]models.RatingHistory, error) {
+	records := make([]models.RatingHistory, 0)
+	if len(directionIDs) == 0 {
+		return records, nil
+	}
+
+	query, args, err := sqlx.In(fmt.Sprintf(
+		`SELECT h.id, h.user_id, h.direction_id, h.position, h.score, h.priority_one_upper,
+				h.submitted_consent_upper, h.budget_places, h.created_at FROM (
+			SELECT *, row_number() OVER (PARTITION BY direction_id ORDER BY created_at DESC, id DESC) AS n
+			FROM %s WHERE user_id = ? AND direction_id IN (?)
+		) h WHERE h.n <= ? ORDER BY h.direction_id, h.created_at DESC, h.id DESC`,
+		ratingsHistoryTable,
+	), userID, directionIDs, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while building rating history query: %w", err)
+	}
+
+	if err := r.db.SelectContext(ctx, &records, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("error while getting rating history records of directions: %w", err)
+	}
+
+	return records, nil
+}
+
+func (r *RatingHistoryImpl) GetForUser(ctx context.Context, userID uint) ([]models.RatingHistory, error) {
+	var records []models.RatingHistory
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE user_id = $1 ORDER BY direction_id, created_at DESC, id DESC", ratingsHistoryTable,
+	)
+	if err := r.db.SelectContext(ctx, &records, query, userID); err != nil {
+		return nil, fmt.Errorf("error while getting user rating history records: %w", err)
+	}
+
+	return records, nil
+}
+
+func (r *RatingHistoryImpl) Clear(ctx context.Context, userID uint) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", ratingsHistoryTable)
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("error while clearing user rating history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type TwoFactorImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewTwoFactorImpl(db *sqlx.DB) *TwoFactorImpl {
+	return &TwoFactorImpl{
+		db:     db,
+		logger: logging.NewLogger("two factor repository"),
+	}
+}
+
+func (r *TwoFactorImpl) GetTOTP(ctx context.Context, userID uint) (*models.UserTOTP, error) {
+	var totp models.UserTOTP
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE user_id = $1", userTOTPTable)
+	if err := r.db.GetContext(ctx, &totp, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting user totp: %w", err)
+	}
+
+	return &totp, nil
+}
+
+// SaveTOTP saves not enabled secret of user, secret of unfinished enrollment is replaced.
+func (r *TwoFactorImpl) SaveTOTP(ctx context.Context, userID uint, secret string) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, secret) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, enabled = false, created_at = now()`,
+		userTOTPTable,
+	)
+	if _, err := r.db.ExecContext(ctx, query, userID, secret); err != nil {
+		return fmt.Errorf("error while saving user totp: %w", err)
+	}
+
+	return nil
+}
+
+// EnableTOTP enables secret of user and replaces user backup codes in one transaction.
+func (r *TwoFactorImpl) EnableTOTP(ctx context.Context, userID uint, backupCodeHashes []string) error {
+	return r.inTransaction(ctx, func(tx *sql.Tx) error {
+		query := fmt.Sprintf("UPDATE %s SET enabled = true WHERE user_id = $1", userTOTPTable)
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("error while enabling user totp: %w", err)
+		}
+
+		return r.replaceBackupCodes(ctx, tx, userID, backupCodeHashes)
+	})
+}
+
+func (r *TwoFactorImpl) DeleteTOTP(ctx context.Context, userID uint) error {
+	return r.inTransaction(ctx, func(tx *sql.Tx) error {
+		query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", totpBackupCodesTable)
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("error while deleting user backup codes: %w", err)
+		}
+
+		query = fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", userTOTPTable)
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("error while deleting user totp: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *TwoFactorImpl) ReplaceBackupCodes(ctx context.Context, userID uint, backupCodeHashes []string) error {
+	return r.inTransaction(ctx, func(tx *sql.Tx) error {
+		return r.replaceBackupCodes(ctx, tx, userID, backupCodeHashes)
+	})
+}
+
+// UseBackupCode marks not used backup code as used, so it can't be used again.
+func (r *TwoFactorImpl) UseBackupCode(ctx context.Context, userID uint, codeHash string) error {
+	query := fmt.Sprintf(
+		"UPDATE %s SET used_at = now() WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL",
+		totpBackupCodesTable,
+	)
+
+	result, err := r.db.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		return fmt.Errorf("error while using backup code: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// CountBackupCodes returns count of not used backup codes of user.
+func (r *TwoFactorImpl) CountBackupCodes(ctx context.Context, userID uint) (int, error) {
+	var count int
+
+	query := fmt.Sprintf("SELECT count(*) FROM %s WHERE user_id = $1 AND used_at IS NULL", totpBackupCodesTable)
+	if err := r.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, fmt.Errorf("error while counting backup codes: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *TwoFactorImpl) replaceBackupCodes(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID uint,
+	backupCodeHashes []string,
+) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", totpBackupCodesTable)
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("error while deleting user backup codes: %w", err)
+	}
+
+	query = fmt.Sprintf("INSERT INTO %s (user_id, code_hash) VALUES ($1, $2)", totpBackupCodesTable)
+	for _, hash := range backupCodeHashes {
+		if _, err := tx.ExecContext(ctx, query, userID, hash); err != nil {
+			return fmt.Errorf("error while creating backup code: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *TwoFactorImpl) inTransaction(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error while beginning transaction: %w", err)
+	}
+
+	if err := f(tx); err != nil {
+		r.logger.WithContext(ctx).Error(err)
+
+		if err := tx.Rollback(); err != nil {
+			return fmt.Errorf("error while rollbacking transaction: %w", err)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error while committing transaction: %w", err)
+	}
+
+	return nil
+}
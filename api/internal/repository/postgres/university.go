@@ -1,12 +1,17 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 )
@@ -23,10 +28,10 @@ func NewUniversityImpl(db *sqlx.DB) *UniversityImpl {
 	}
 }
 
-func (r *UniversityImpl) GetAll() ([]rdto.University, error) {
+func (r *UniversityImpl) GetAll(ctx context.Context) ([]rdto.University, error) {
 	var universities []rdto.University
-	if err := r.db.Select(
-		&universities, fmt.Sprintf("SELECT id, name, full_name FROM %s", universitiesTable),
+	if err := r.db.SelectContext(
+		ctx, &universities, fmt.Sprintf("SELECT id, name, full_name FROM %s", universitiesTable),
 	); err != nil {
 		return nil, fmt.Errorf("error while getting all universities: %w", err)
 	}
@@ -34,33 +39,57 @@ func (r *UniversityImpl) GetAll() ([]rdto.University, error) {
 	return universities, nil
 }
 
-func (r *UniversityImpl) GetForUser(userID uint) ([]rdto.University, error) {
+func (r *UniversityImpl) GetForUser(ctx context.Context, userID uint) ([]rdto.University, error) {
 	var universities []rdto.University
 
 	query := fmt.Sprintf(
 		"SELECT un.id, un.name, un.full_name FROM %s un INNER JOIN %s uu on un.id = uu.university_id WHERE uu.user_id = $1",
 		universitiesTable, usersUniversitiesTable,
 	)
-	if err := r.db.Select(&universities, query, userID); err != nil {
+	if err := r.db.SelectContext(ctx, &universities, query, userID); err != nil {
 		return nil, fmt.Errorf("error while getting universities for user: %w", err)
 	}
 
 	return universities, nil
 }
 
-func (r *UniversityImpl) GetByID(id uint) (*models.University, error) {
+func (r *UniversityImpl) GetByID(ctx context.Context, id uint) (*models.University, error) {
 	var university models.University
 
 	query := fmt.Sprintf("SELECT * FROM %s WHERE id=$1", universitiesTable)
-	if err := r.db.Get(&university, query, id); err != nil {
+	if err := r.db.GetContext(ctx, &university, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
 		return nil, fmt.Errorf("error while getting university by id: %w", err)
 	}
 
 	return &university, nil
 }
 
-func (r *UniversityImpl) SetForUser(userID uint, universityIDs dto.IDs) error {
-	tx, err := r.db.Begin()
+func (r *UniversityImpl) GetByIDs(ctx context.Context, ids []uint) ([]rdto.University, error) {
+	universities := make([]rdto.University, 0)
+	if len(ids) == 0 {
+		return universities, nil
+	}
+
+	query, args, err := sqlx.In(
+		fmt.Sprintf("SELECT id, name, full_name FROM %s WHERE id IN (?) ORDER BY id", universitiesTable), ids,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while building universities query: %w", err)
+	}
+
+	if err := r.db.SelectContext(ctx, &universities, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("error while getting universities by ids: %w", err)
+	}
+
+	return universities, nil
+}
+
+func (r *UniversityImpl) SetForUser(ctx context.Context, userID uint, universityIDs dto.IDs) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		r.logger.Error(err)
 
@@ -69,13 +98,17 @@ func (r *UniversityImpl) SetForUser(userID uint, universityIDs dto.IDs) error {
 
 	query := fmt.Sprintf("INSERT INTO %s (user_id, university_id) VALUES ($1, $2)", usersUniversitiesTable)
 	for _, universityID := range universityIDs.IDs {
-		if _, err := tx.Exec(query, userID, universityID); err != nil {
+		if _, err := tx.ExecContext(ctx, query, userID, universityID); err != nil {
 			r.logger.Error(err)
 
 			if err := tx.Rollback(); err != nil {
 				return fmt.Errorf("error while rollbacking transaction: %w", err)
 			}
 
+			if isForeignKeyViolation(err) {
+				return repository.ErrRecordNotFound
+			}
+
 			return fmt.Errorf("error while adding university to user: %w", err)
 		}
 	}
@@ -93,11 +126,55 @@ func (r *UniversityImpl) SetForUser(userID uint, universityIDs dto.IDs) error {
 	return nil
 }
 
-func (r *UniversityImpl) Clear(userID uint) error {
+func (r *UniversityImpl) Clear(ctx context.Context, userID uint) error {
 	query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", usersUniversitiesTable)
-	if _, err := r.db.Exec(query, userID); err != nil {
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
 		return fmt.Errorf("error while deleting university from user: %w", err)
 	}
 
 	return nil
 }
+
+func (r *UniversityImpl) Patch(ctx context.Context, id uint, data rdto.UniversityPatching) error {
+	setValues := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	if data.Name != nil {
+		setValues = append(setValues, fmt.Sprintf("name=$%d", argID))
+		args = append(args, *data.Name)
+		argID++
+	}
+
+	if data.FullName != nil {
+		setValues = append(setValues, fmt.Sprintf("full_name=$%d", argID))
+		args = append(args, *data.FullName)
+		argID++
+	}
+
+	if data.DirectionsPageURL != nil {
+		setValues = append(setValues, fmt.Sprintf("directions_page_url=$%d", argID))
+		args = append(args, *data.DirectionsPageURL)
+		argID++
+	}
+
+	if len(setValues) == 0 {
+		return nil
+	}
+
+	setQuery := strings.Join(setValues, ", ")
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE id=$%d", universitiesTable, setQuery, argID)
+
+	args = append(args, id)
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error while patching university: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
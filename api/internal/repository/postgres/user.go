@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -24,57 +27,85 @@ func NewUserImpl(db *sqlx.DB) *UserImpl {
 	}
 }
 
-func (r *UserImpl) Create(user rdto.UserCreating) (uint, error) {
+// NewID reserves id of user created later by Create, so values bound to user id, e.g.
+// encrypted SNILS, can be prepared before user is created.
+func (r *UserImpl) NewID(ctx context.Context) (uint, error) {
 	var id uint
 
+	query := fmt.Sprintf("SELECT nextval(pg_get_serial_sequence('%s', 'id'))", usersTable)
+	if err := r.db.GetContext(ctx, &id, query); err != nil {
+		return 0, fmt.Errorf("error while reserving user id: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *UserImpl) Create(ctx context.Context, id uint, user rdto.UserCreating) error {
 	query := fmt.Sprintf(
-		`INSERT INTO %s (username, password, first_name, middle_name, last_name, snils) 
-		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		`INSERT INTO %s (id, username, password, first_name, middle_name, last_name, snils, snils_hash, snils_mask) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
 		usersTable,
 	)
-	row := r.db.QueryRow(query, user.Username, user.Password, user.FirstName, user.MiddleName, user.LastName, user.Snils)
-
-	if err := row.Scan(&id); err != nil {
-		r.logger.Error(err)
-
-		return 0, repository.ErrUserAlreadyExists
+	if _, err := r.db.ExecContext(
+		ctx, query, id, user.Username, user.Password, user.FirstName, user.MiddleName, user.LastName,
+		user.Snils, newNullString(user.SnilsHash), user.SnilsMask,
+	); err != nil {
+		if isUniqueViolation(err) {
+			return repository.ErrUserAlreadyExists
+		}
+
+		return fmt.Errorf("error while creating user: %w", err)
 	}
 
-	return id, nil
+	return nil
 }
 
-func (r *UserImpl) GetUserByUsername(username string) (*models.User, error) {
+func (r *UserImpl) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
 
 	query := fmt.Sprintf("SELECT * FROM %s WHERE username=$1", usersTable)
-	if err := r.db.Get(&user, query, username); err != nil {
-		return nil, repository.ErrRecordNotFound
+	if err := r.db.GetContext(ctx, &user, query, username); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting user by username: %w", err)
 	}
 
 	return &user, nil
 }
 
-func (r *UserImpl) GetUserByID(id uint) (*models.User, error) {
+func (r *UserImpl) GetUserByID(ctx context.Context, id uint) (*models.User, error) {
 	var user models.User
 
 	query := fmt.Sprintf("SELECT * FROM %s WHERE id=$1", usersTable)
-	if err := r.db.Get(&user, query, id); err != nil {
-		return nil, repository.ErrRecordNotFound
+	if err := r.db.GetContext(ctx, &user, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting user by id: %w", err)
 	}
 
 	return &user, nil
 }
 
-func (r *UserImpl) UpdatePassword(id uint, password string) error {
+func (r *UserImpl) UpdatePassword(ctx context.Context, id uint, password string) error {
 	query := fmt.Sprintf("UPDATE %s ut SET password=$1 WHERE ut.id=$2", usersTable)
-	if _, err := r.db.Exec(query, password, id); err != nil {
+
+	result, err := r.db.ExecContext(ctx, query, password, id)
+	if err != nil {
+		return fmt.Errorf("error while updating user password: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
 		return repository.ErrRecordNotFound
 	}
 
 	return nil
 }
 
-func (r *UserImpl) PatchUser(id uint, data rdto.UserPatching) error {
+func (r *UserImpl) PatchUser(ctx context.Context, id uint, data rdto.UserPatching) error {
 	setValues := make([]string, 0)
 	args := make([]interface{}, 0)
 	argID := 1
@@ -103,16 +134,34 @@ func (r *UserImpl) PatchUser(id uint, data rdto.UserPatching) error {
 		argID++
 	}
 
+	if data.SnilsHash != nil {
+		setValues = append(setValues, fmt.Sprintf("snils_hash=$%d", argID))
+		args = append(args, newNullString(*data.SnilsHash))
+		argID++
+	}
+
+	if data.SnilsMask != nil {
+		setValues = append(setValues, fmt.Sprintf("snils_mask=$%d", argID))
+		args = append(args, *data.SnilsMask)
+		argID++
+	}
+
+	if len(setValues) == 0 {
+		return nil
+	}
+
 	setQuery := strings.Join(setValues, ", ")
 	query := fmt.Sprintf("UPDATE %s ut SET %s WHERE ut.id=$%d", usersTable, setQuery, argID)
 
 	args = append(args, id)
 
-	result, err := r.db.Exec(query, args...)
+	result, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error(err)
+		if isUniqueViolation(err) {
+			return repository.ErrSnilsAlreadyUsed
+		}
 
-		return repository.ErrRecordNotFound
+		return fmt.Errorf("error while patching user: %w", err)
 	}
 
 	if n, _ := result.RowsAffected(); n == 0 {
@@ -122,50 +171,249 @@ func (r *UserImpl) PatchUser(id uint, data rdto.UserPatching) error {
 	return nil
 }
 
-func (r *UserImpl) GetUsername(id uint) (*rdto.Username, error) {
+func (r *UserImpl) GetUsername(ctx context.Context, id uint) (*rdto.Username, error) {
 	var username rdto.Username
 
 	query := fmt.Sprintf(
 		"SELECT (username) FROM %s WHERE id=$1",
 		usersTable,
 	)
-	if err := r.db.Get(&username, query, id); err != nil {
-		r.logger.Error(err)
+	if err := r.db.GetContext(ctx, &username, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
 
-		return nil, repository.ErrRecordNotFound
+		return nil, fmt.Errorf("error while getting user username: %w", err)
 	}
 
 	return &username, nil
 }
 
-func (r *UserImpl) GetSnils(id uint) (*rdto.Snils, error) {
+func (r *UserImpl) GetSnils(ctx context.Context, id uint) (*rdto.Snils, error) {
 	var snils rdto.Snils
 
 	query := fmt.Sprintf(
 		"SELECT snils FROM %s WHERE id=$1",
 		usersTable,
 	)
-	if err := r.db.Get(&snils, query, id); err != nil {
-		r.logger.Error(err)
+	if err := r.db.GetContext(ctx, &snils, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
 
-		return nil, repository.ErrRecordNotFound
+		return nil, fmt.Errorf("error while getting user snils: %w", err)
 	}
 
 	return &snils, nil
 }
 
-func (r *UserImpl) GetProfile(id uint) (*rdto.UserProfile, error) {
+// GetAllSnils returns stored SNILS of all users, e.g. for encrypting them again.
+func (r *UserImpl) GetAllSnils(ctx context.Context) ([]rdto.UserSnils, error) {
+	var snils []rdto.UserSnils
+
+	query := fmt.Sprintf("SELECT id, snils FROM %s ORDER BY id", usersTable)
+	if err := r.db.SelectContext(ctx, &snils, query); err != nil {
+		return nil, fmt.Errorf("error while getting users snils: %w", err)
+	}
+
+	return snils, nil
+}
+
+func (r *UserImpl) GetIDBySnilsHash(ctx context.Context, hash string) (uint, error) {
+	var id uint
+
+	query := fmt.Sprintf("SELECT id FROM %s WHERE snils_hash=$1", usersTable)
+	if err := r.db.GetContext(ctx, &id, query, hash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, repository.ErrRecordNotFound
+		}
+
+		return 0, fmt.Errorf("error while getting user by snils hash: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *UserImpl) GetProfile(ctx context.Context, id uint) (*rdto.UserProfile, error) {
 	var userProfile rdto.UserProfile
 
 	query := fmt.Sprintf(
-		"SELECT username, first_name, middle_name, last_name, snils FROM %s WHERE id=$1",
+		`SELECT username, first_name, middle_name, last_name, snils_mask as snils, COALESCE(email, '') as email,
+			is_email_verified, email_notifications, language, telegram_chat_id IS NOT NULL as is_telegram_linked,
+			snils = '' as is_snils_required
+		FROM %s WHERE id=$1`,
 		usersTable,
 	)
-	if err := r.db.Get(&userProfile, query, id); err != nil {
-		r.logger.Error(err)
+	if err := r.db.GetContext(ctx, &userProfile, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
 
-		return nil, repository.ErrRecordNotFound
+		return nil, fmt.Errorf("error while getting user profile: %w", err)
 	}
 
 	return &userProfile, nil
 }
+
+func (r *UserImpl) SetEmail(ctx context.Context, id uint, email string) error {
+	query := fmt.Sprintf("UPDATE %s ut SET email=$1, is_email_verified=true WHERE ut.id=$2", usersTable)
+
+	result, err := r.db.ExecContext(ctx, query, email, id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.ErrEmailAlreadyUsed
+		}
+
+		return fmt.Errorf("error while setting email: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *UserImpl) PatchEmailSettings(ctx context.Context, id uint, data rdto.EmailSettingsPatching) error {
+	setValues := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	if data.Language != nil {
+		setValues = append(setValues, fmt.Sprintf("language=$%d", argID))
+		args = append(args, *data.Language)
+		argID++
+	}
+
+	if data.EmailNotifications != nil {
+		setValues = append(setValues, fmt.Sprintf("email_notifications=$%d", argID))
+		args = append(args, *data.EmailNotifications)
+		argID++
+	}
+
+	if len(setValues) == 0 {
+		return nil
+	}
+
+	setQuery := strings.Join(setValues, ", ")
+	query := fmt.Sprintf("UPDATE %s ut SET %s WHERE ut.id=$%d", usersTable, setQuery, argID)
+
+	args = append(args, id)
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error while patching user email settings: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *UserImpl) GetUserByTelegramChatID(ctx context.Context, chatID int64) (*models.User, error) {
+	var user models.User
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE telegram_chat_id=$1", usersTable)
+	if err := r.db.GetContext(ctx, &user, query, chatID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting user by telegram chat: %w", err)
+	}
+
+	return &user, nil
+}
+
+// SetTelegramChatID links Telegram chat to user. Chat is unlinked from the
+// previously linked user, so one chat is never linked to several accounts.
+func (r *UserImpl) SetTelegramChatID(ctx context.Context, id uint, chatID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+
+		return fmt.Errorf("error while beginning transaction: %w", err)
+	}
+
+	queries := []struct {
+		query string
+		args  []interface{}
+	}{
+		{
+			query: fmt.Sprintf("UPDATE %s ut SET telegram_chat_id=NULL WHERE ut.telegram_chat_id=$1", usersTable),
+			args:  []interface{}{chatID},
+		},
+		{
+			query: fmt.Sprintf("UPDATE %s ut SET telegram_chat_id=$1 WHERE ut.id=$2", usersTable),
+			args:  []interface{}{chatID, id},
+		},
+	}
+
+	for _, q := range queries {
+		if _, err := tx.ExecContext(ctx, q.query, q.args...); err != nil {
+			r.logger.Error(err)
+
+			if err := tx.Rollback(); err != nil {
+				return fmt.Errorf("error while rollbacking transaction: %w", err)
+			}
+
+			return fmt.Errorf("error while setting user telegram chat: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error(err)
+
+		if err := tx.Rollback(); err != nil {
+			return fmt.Errorf("error while rollbacking transaction: %w", err)
+		}
+
+		return fmt.Errorf("error while committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *UserImpl) ClearTelegramChatID(ctx context.Context, id uint) error {
+	query := fmt.Sprintf("UPDATE %s ut SET telegram_chat_id=NULL WHERE ut.id=$1", usersTable)
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		r.logger.Error(err)
+
+		return fmt.Errorf("error while clearing user telegram chat: %w", err)
+	}
+
+	return nil
+}
+
+func (r *UserImpl) SetRole(ctx context.Context, id uint, role string) error {
+	query := fmt.Sprintf("UPDATE %s ut SET role=$1 WHERE ut.id=$2", usersTable)
+
+	result, err := r.db.ExecContext(ctx, query, role, id)
+	if err != nil {
+		return fmt.Errorf("error while setting user role: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes user, rows of user in other tables are deleted by cascade.
+func (r *UserImpl) Delete(ctx context.Context, id uint) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1", usersTable)
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("error while deleting user: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type UserIdentityImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewUserIdentityImpl(db *sqlx.DB) *UserIdentityImpl {
+	return &UserIdentityImpl{
+		db:     db,
+		logger: logging.NewLogger("user identity repository"),
+	}
+}
+
+func (r *UserIdentityImpl) Create(ctx context.Context, identity rdto.UserIdentityCreating) (uint, error) {
+	var id uint
+
+	query := fmt.Sprintf(
+		"INSERT INTO %s (user_id, provider, subject, email) VALUES ($1, $2, $3, $4) RETURNING id",
+		userIdentitiesTable,
+	)
+	if err := r.db.QueryRowContext(
+		ctx, query, identity.UserID, identity.Provider, identity.Subject, newNullString(identity.Email),
+	).Scan(&id); err != nil {
+		if isUniqueViolation(err) {
+			return 0, repository.ErrIdentityAlreadyLinked
+		}
+
+		return 0, fmt.Errorf("error while creating identity: %w", err)
+	}
+
+	return id, nil
+}
+
+// CreateWithUser creates user with identity in one transaction and returns id of user.
+func (r *UserIdentityImpl) CreateWithUser(
+	ctx context.Context,
+	user rdto.UserCreating,
+	identity rdto.UserIdentityCreating,
+) (uint, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error while beginning transaction: %w", err)
+	}
+
+	var userID uint
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (username, password, first_name, middle_name, last_name, snils, snils_hash, snils_mask) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+		usersTable,
+	)
+	if err := tx.QueryRowContext(
+		ctx, query, user.Username, user.Password, user.FirstName, user.MiddleName, user.LastName,
+		user.Snils, newNullString(user.SnilsHash), user.SnilsMask,
+	).Scan(&userID); err != nil {
+		if err := tx.Rollback(); err != nil {
+			return 0, fmt.Errorf("error while rollbacking transaction: %w", err)
+		}
+
+		if isUniqueViolation(err) {
+			return 0, repository.ErrUserAlreadyExists
+		}
+
+		return 0, fmt.Errorf("error while creating user: %w", err)
+	}
+
+	query = fmt.Sprintf(
+		"INSERT INTO %s (user_id, provider, subject, email) VALUES ($1, $2, $3, $4)", userIdentitiesTable,
+	)
+	if _, err := tx.ExecContext(
+		ctx, query, userID, identity.Provider, identity.Subject, newNullString(identity.Email),
+	); err != nil {
+		if err := tx.Rollback(); err != nil {
+			return 0, fmt.Errorf("error while rollbacking transaction: %w", err)
+		}
+
+		if isUniqueViolation(err) {
+			return 0, repository.ErrIdentityAlreadyLinked
+		}
+
+		return 0, fmt.Errorf("error while creating identity: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error while committing transaction: %w", err)
+	}
+
+	return userID, nil
+}
+
+func (r *UserIdentityImpl) GetByProviderSubject(
+	ctx context.Context,
+	provider string,
+	subject string,
+) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE provider = $1 AND subject = $2", userIdentitiesTable)
+	if err := r.db.GetContext(ctx, &identity, query, provider, subject); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting user identity by provider subject: %w", err)
+	}
+
+	return &identity, nil
+}
+
+func (r *UserIdentityImpl) GetForUser(ctx context.Context, userID uint) ([]models.UserIdentity, error) {
+	var identities []models.UserIdentity
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE user_id = $1 ORDER BY id", userIdentitiesTable)
+	if err := r.db.SelectContext(ctx, &identities, query, userID); err != nil {
+		return nil, fmt.Errorf("error while getting user identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+func (r *UserIdentityImpl) Delete(ctx context.Context, userID uint, provider string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE user_id = $1 AND provider = $2", userIdentitiesTable)
+
+	result, err := r.db.ExecContext(ctx, query, userID, provider)
+	if err != nil {
+		return fmt.Errorf("error while deleting user identity: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func newNullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type WebhookImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewWebhookImpl(db *sqlx.DB) *WebhookImpl {
+	return &WebhookImpl{
+		db:     db,
+		logger: logging.NewLogger("webhook repository"),
+	}
+}
+
+func (r *WebhookImpl) Create(ctx context.Context, webhook rdto.WebhookCreating) (uint, error) {
+	var id uint
+
+	query := fmt.Sprintf("INSERT INTO %s (user_id, url, secret) VALUES ($1, $2, $3) RETURNING id", webhooksTable)
+	if err := r.db.QueryRowContext(ctx, query, webhook.UserID, webhook.URL, webhook.Secret).Scan(&id); err != nil {
+		return 0, fmt.Errorf("error while creating webhook: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *WebhookImpl) GetByID(ctx context.Context, userID uint, id uint) (*models.Webhook, error) {
+	var webhook models.Webhook
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id = $1 AND user_id = $2", webhooksTable)
+	if err := r.db.GetContext(ctx, &webhook, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("error while getting webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+func (r *WebhookImpl) GetForUser(ctx context.Context, userID uint) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE user_id = $1 ORDER BY id", webhooksTable)
+	if err := r.db.SelectContext(ctx, &webhooks, query, userID); err != nil {
+		return nil, fmt.Errorf("error while getting user webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookImpl) Delete(ctx context.Context, userID uint, id uint) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND user_id = $2", webhooksTable)
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("error while deleting webhook: %w", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repository.ErrRecordNotFound
+	}
+
+	return nil
+}
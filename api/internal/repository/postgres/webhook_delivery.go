@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type WebhookDeliveryImpl struct {
+	db     *sqlx.DB
+	logger *logging.Logger
+}
+
+func NewWebhookDeliveryImpl(db *sqlx.DB) *WebhookDeliveryImpl {
+	return &WebhookDeliveryImpl{
+		db:     db,
+		logger: logging.NewLogger("webhook delivery repository"),
+	}
+}
+
+// ClaimPending locks up to limit pending deliveries which are due and postpones their next attempt
+// by lease, so concurrent dispatchers (e.g. several api instances) don't deliver the same event twice.
+func (r *WebhookDeliveryImpl) ClaimPending(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]rdto.WebhookDeliveryTask, error) {
+	var tasks []rdto.WebhookDeliveryTask
+
+	query := fmt.Sprintf(
+		`WITH claimed AS (
+			UPDATE %s SET next_attempt_at = now() + $2 * interval '1 second'
+			WHERE id IN (
+				SELECT id FROM %s WHERE status = $3 AND next_attempt_at <= now()
+				ORDER BY next_attempt_at LIMIT $1 FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, webhook_id, event_id, event_type, payload, attempts
+		)
+		SELECT c.id, c.webhook_id, w.url, w.secret, c.event_id, c.event_type, c.payload, c.attempts
+		FROM claimed c INNER JOIN %s w on c.webhook_id = w.id`,
+		webhookDeliveriesTable, webhookDeliveriesTable, webhooksTable,
+	)
+	if err := r.db.SelectContext(ctx, &tasks, query, limit, lease.Seconds(), models.WebhookDeliveryPending); err != nil {
+		return nil, fmt.Errorf("error while claiming pending webhook deliveries: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func (r *WebhookDeliveryImpl) MarkDelivered(ctx context.Context, id uint, responseCode int) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET status = $1, attempts = attempts + 1, last_response_code = $2, last_error = NULL,
+			delivered_at = now() WHERE id = $3`,
+		webhookDeliveriesTable,
+	)
+	if _, err := r.db.ExecContext(ctx, query, models.WebhookDeliveryDelivered, responseCode, id); err != nil {
+		return fmt.Errorf("error while marking webhook delivery as delivered: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookDeliveryImpl) Reschedule(
+	ctx context.Context,
+	id uint,
+	responseCode int,
+	lastError string,
+	delay time.Duration,
+) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET attempts = attempts + 1, last_response_code = $1, last_error = $2,
+			next_attempt_at = now() + $3 * interval '1 second' WHERE id = $4`,
+		webhookDeliveriesTable,
+	)
+	if _, err := r.db.ExecContext(
+		ctx, query, nullableResponseCode(responseCode), lastError, delay.Seconds(), id,
+	); err != nil {
+		return fmt.Errorf("error while rescheduling webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookDeliveryImpl) MarkFailed(ctx context.Context, id uint, responseCode int, lastError string) error {
+	query := fmt.Sprintf(
+		"UPDATE %s SET status = $1, attempts = attempts + 1, last_response_code = $2, last_error = $3 WHERE id = $4",
+		webhookDeliveriesTable,
+	)
+	if _, err := r.db.ExecContext(
+		ctx, query, models.WebhookDeliveryFailed, nullableResponseCode(responseCode), lastError, id,
+	); err != nil {
+		return fmt.Errorf("error while marking webhook delivery as failed: %w", err)
+	}
+
+	return nil
+}
+
+func nullableResponseCode(responseCode int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(responseCode), Valid: responseCode != 0}
+}
+
+func (r *WebhookDeliveryImpl) GetForWebhook(
+	ctx context.Context,
+	webhookID uint,
+	limit int,
+) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE webhook_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2",
+		webhookDeliveriesTable,
+	)
+	if err := r.db.SelectContext(ctx, &deliveries, query, webhookID, limit); err != nil {
+		return nil, fmt.Errorf("error while getting webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,10 @@
+package rdto
+
+type AlertRuleCreating struct {
+	UserID      uint   `db:"user_id"`
+	DirectionID uint   `db:"direction_id"`
+	Metric      string `db:"metric"`
+	Operator    string `db:"operator"`
+	Threshold   int    `db:"threshold"`
+	Cooldown    int    `db:"cooldown"`
+}
@@ -0,0 +1,8 @@
+package rdto
+
+type AlertRuleUpdating struct {
+	Metric    string `db:"metric"`
+	Operator  string `db:"operator"`
+	Threshold int    `db:"threshold"`
+	Cooldown  int    `db:"cooldown"`
+}
@@ -0,0 +1,10 @@
+package rdto
+
+type AuditLogCreating struct {
+	UserID    uint              `db:"user_id"`
+	Action    string            `db:"action"`
+	IP        string            `db:"ip"`
+	UserAgent string            `db:"user_agent"`
+	RequestID string            `db:"request_id"`
+	Metadata  map[string]string `db:"metadata"`
+}
@@ -0,0 +1,13 @@
+package rdto
+
+import "time"
+
+// AuditLogFilter selects audit log records, empty fields don't filter records.
+type AuditLogFilter struct {
+	UserID *uint
+	Action string
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,11 @@
+package rdto
+
+// DirectionFilter selects directions ordered by id, empty fields don't filter directions.
+// Directions are selected after AfterID, zero Limit selects all of them.
+type DirectionFilter struct {
+	UniversityID *uint
+	Search       string
+	CodePrefix   string
+	AfterID      uint
+	Limit        int
+}
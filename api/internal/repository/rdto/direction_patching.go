@@ -0,0 +1,6 @@
+package rdto
+
+type DirectionPatching struct {
+	Name *string `db:"name"`
+	URL  *string `db:"url"`
+}
@@ -0,0 +1,6 @@
+package rdto
+
+type EmailSettingsPatching struct {
+	Language           *string `db:"language"`
+	EmailNotifications *bool   `db:"email_notifications"`
+}
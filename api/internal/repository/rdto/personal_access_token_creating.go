@@ -0,0 +1,12 @@
+package rdto
+
+import "database/sql"
+
+type PersonalAccessTokenCreating struct {
+	UserID    uint         `db:"user_id"`
+	Name      string       `db:"name"`
+	TokenHash string       `db:"token_hash"`
+	Scopes    string       `db:"scopes"`
+	RateLimit int          `db:"rate_limit"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+}
@@ -0,0 +1,9 @@
+package rdto
+
+import "github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+
+// PersonalAccessTokenWithRole is personal access token with role of its user.
+type PersonalAccessTokenWithRole struct {
+	models.PersonalAccessToken
+	Role string `db:"role"`
+}
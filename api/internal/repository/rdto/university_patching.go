@@ -0,0 +1,7 @@
+package rdto
+
+type UniversityPatching struct {
+	Name              *string `db:"name"`
+	FullName          *string `db:"full_name"`
+	DirectionsPageURL *string `db:"directions_page_url"`
+}
@@ -7,4 +7,6 @@ type UserCreating struct {
 	MiddleName string `db:"middle_name"`
 	LastName   string `db:"last_name"`
 	Snils      string `db:"snils"`
+	SnilsHash  string `db:"snils_hash"`
+	SnilsMask  string `db:"snils_mask"`
 }
@@ -0,0 +1,8 @@
+package rdto
+
+type UserIdentityCreating struct {
+	UserID   uint   `db:"user_id"`
+	Provider string `db:"provider"`
+	Subject  string `db:"subject"`
+	Email    string `db:"email"`
+}
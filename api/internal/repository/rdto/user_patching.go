@@ -5,4 +5,6 @@ type UserPatching struct {
 	MiddleName *string `db:"middle_name"`
 	LastName   *string `db:"last_name"`
 	Snils      *string `db:"snils"`
+	SnilsHash  *string `db:"snils_hash"`
+	SnilsMask  *string `db:"snils_mask"`
 }
@@ -1,9 +1,15 @@
 package rdto
 
 type UserProfile struct {
-	Username   string `db:"username"`
-	FirstName  string `db:"first_name"`
-	MiddleName string `db:"middle_name"`
-	LastName   string `db:"last_name"`
-	Snils      string `db:"snils"`
+	Username           string `db:"username"`
+	FirstName          string `db:"first_name"`
+	MiddleName         string `db:"middle_name"`
+	LastName           string `db:"last_name"`
+	Snils              string `db:"snils"`
+	Email              string `db:"email"`
+	IsEmailVerified    bool   `db:"is_email_verified"`
+	EmailNotifications bool   `db:"email_notifications"`
+	Language           string `db:"language"`
+	IsTelegramLinked   bool   `db:"is_telegram_linked"`
+	IsSnilsRequired    bool   `db:"is_snils_required"`
 }
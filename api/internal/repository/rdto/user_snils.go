@@ -0,0 +1,6 @@
+package rdto
+
+type UserSnils struct {
+	ID    uint   `db:"id"`
+	Snils string `db:"snils"`
+}
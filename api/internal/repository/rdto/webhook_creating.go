@@ -0,0 +1,7 @@
+package rdto
+
+type WebhookCreating struct {
+	UserID uint   `db:"user_id"`
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
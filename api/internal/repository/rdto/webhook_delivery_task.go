@@ -0,0 +1,12 @@
+package rdto
+
+type WebhookDeliveryTask struct {
+	ID        uint   `db:"id"`
+	WebhookID uint   `db:"webhook_id"`
+	URL       string `db:"url"`
+	Secret    string `db:"secret"`
+	EventID   string `db:"event_id"`
+	EventType string `db:"event_type"`
+	Payload   string `db:"payload"`
+	Attempts  int    `db:"attempts"`
+}
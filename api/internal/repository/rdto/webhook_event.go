@@ -0,0 +1,8 @@
+package rdto
+
+// WebhookEvent is event which is enqueued for delivery to every webhook of user.
+type WebhookEvent struct {
+	ID      string
+	Type    string
+	Payload string
+}
@@ -1,41 +1,139 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
 )
 
 type User interface {
-	Create(user rdto.UserCreating) (uint, error)
-	GetUserByUsername(username string) (*models.User, error)
-	GetUserByID(id uint) (*models.User, error)
-	UpdatePassword(id uint, password string) error
-	PatchUser(id uint, data rdto.UserPatching) error
-	GetUsername(id uint) (*rdto.Username, error)
-	GetSnils(id uint) (*rdto.Snils, error)
-	GetProfile(id uint) (*rdto.UserProfile, error)
+	NewID(ctx context.Context) (uint, error)
+	Create(ctx context.Context, id uint, user rdto.UserCreating) error
+	GetUserByUsername(ctx context.Context, username string) (*models.User, error)
+	GetUserByID(ctx context.Context, id uint) (*models.User, error)
+	UpdatePassword(ctx context.Context, id uint, password string) error
+	PatchUser(ctx context.Context, id uint, data rdto.UserPatching) error
+	GetUsername(ctx context.Context, id uint) (*rdto.Username, error)
+	GetSnils(ctx context.Context, id uint) (*rdto.Snils, error)
+	GetAllSnils(ctx context.Context) ([]rdto.UserSnils, error)
+	GetIDBySnilsHash(ctx context.Context, hash string) (uint, error)
+	GetProfile(ctx context.Context, id uint) (*rdto.UserProfile, error)
+	SetEmail(ctx context.Context, id uint, email string) error
+	PatchEmailSettings(ctx context.Context, id uint, data rdto.EmailSettingsPatching) error
+	GetUserByTelegramChatID(ctx context.Context, chatID int64) (*models.User, error)
+	SetTelegramChatID(ctx context.Context, id uint, chatID int64) error
+	ClearTelegramChatID(ctx context.Context, id uint) error
+	SetRole(ctx context.Context, id uint, role string) error
+	Delete(ctx context.Context, id uint) error
 }
 
 type University interface {
-	GetAll() ([]rdto.University, error)
-	GetByID(id uint) (*models.University, error)
-	GetForUser(userID uint) ([]rdto.University, error)
-	SetForUser(userID uint, universityIDs dto.IDs) error
-	Clear(userID uint) error
+	GetAll(ctx context.Context) ([]rdto.University, error)
+	GetByID(ctx context.Context, id uint) (*models.University, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]rdto.University, error)
+	GetForUser(ctx context.Context, userID uint) ([]rdto.University, error)
+	SetForUser(ctx context.Context, userID uint, universityIDs dto.IDs) error
+	Clear(ctx context.Context, userID uint) error
+	Patch(ctx context.Context, id uint, data rdto.UniversityPatching) error
 }
 
 type Direction interface {
-	GetAll() ([]rdto.Direction, error)
-	GetByID(id uint) (*models.Direction, error)
-	GetForUser(userID uint) ([]rdto.Direction, error)
-	SetForUser(userID uint, directionIDs dto.IDs) error
-	GetUniversityID(id uint) (*rdto.UniversityID, error)
-	Clear(userID uint) error
+	Find(ctx context.Context, filter rdto.DirectionFilter) ([]rdto.Direction, error)
+	GetByID(ctx context.Context, id uint) (*models.Direction, error)
+	GetForUser(ctx context.Context, userID uint) ([]rdto.Direction, error)
+	SetForUser(ctx context.Context, userID uint, directionIDs dto.IDs) error
+	GetForUserByID(ctx context.Context, userID uint, directionID uint) (*rdto.Direction, error)
+	GetForUniversities(ctx context.Context, universityIDs []uint) ([]rdto.Direction, error)
+	AddForUser(ctx context.Context, userID uint, directionID uint) (bool, error)
+	RemoveForUser(ctx context.Context, userID uint, directionID uint) error
+	GetUniversityID(ctx context.Context, id uint) (*rdto.UniversityID, error)
+	GetTrackingUserIDs(ctx context.Context) ([]uint, error)
+	Clear(ctx context.Context, userID uint) error
+	Patch(ctx context.Context, id uint, data rdto.DirectionPatching) error
+}
+
+type RatingHistory interface {
+	Create(
+		ctx context.Context, userID uint, directionID uint, result dto.ParsingResult, events []rdto.WebhookEvent,
+	) error
+	GetLatest(ctx context.Context, userID uint, directionID uint) (*models.RatingHistory, error)
+	GetForDirection(ctx context.Context, userID uint, directionID uint, limit int) ([]models.RatingHistory, error)
+	GetForDirections(ctx context.Context, userID uint, directionIDs []uint, limit int) ([]models.RatingHistory, error)
+	GetForUser(ctx context.Context, userID uint) ([]models.RatingHistory, error)
+	Clear(ctx context.Context, userID uint) error
+}
+
+type Webhook interface {
+	Create(ctx context.Context, webhook rdto.WebhookCreating) (uint, error)
+	GetByID(ctx context.Context, userID uint, id uint) (*models.Webhook, error)
+	GetForUser(ctx context.Context, userID uint) ([]models.Webhook, error)
+	Delete(ctx context.Context, userID uint, id uint) error
+}
+
+type WebhookDelivery interface {
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]rdto.WebhookDeliveryTask, error)
+	MarkDelivered(ctx context.Context, id uint, responseCode int) error
+	Reschedule(ctx context.Context, id uint, responseCode int, lastError string, delay time.Duration) error
+	MarkFailed(ctx context.Context, id uint, responseCode int, lastError string) error
+	GetForWebhook(ctx context.Context, webhookID uint, limit int) ([]models.WebhookDelivery, error)
+}
+
+type AlertRule interface {
+	Create(ctx context.Context, rule rdto.AlertRuleCreating) (uint, error)
+	GetByID(ctx context.Context, userID uint, id uint) (*models.AlertRule, error)
+	GetForUser(ctx context.Context, userID uint) ([]models.AlertRule, error)
+	GetForDirection(ctx context.Context, userID uint, directionID uint) ([]models.AlertRule, error)
+	Update(ctx context.Context, userID uint, id uint, rule rdto.AlertRuleUpdating) error
+	Delete(ctx context.Context, userID uint, id uint) error
+	MarkTriggered(ctx context.Context, id uint) (bool, error)
+}
+
+type UserIdentity interface {
+	Create(ctx context.Context, identity rdto.UserIdentityCreating) (uint, error)
+	CreateWithUser(ctx context.Context, user rdto.UserCreating, identity rdto.UserIdentityCreating) (uint, error)
+	GetByProviderSubject(ctx context.Context, provider string, subject string) (*models.UserIdentity, error)
+	GetForUser(ctx context.Context, userID uint) ([]models.UserIdentity, error)
+	Delete(ctx context.Context, userID uint, provider string) error
+}
+
+type AuditLog interface {
+	Create(ctx context.Context, record rdto.AuditLogCreating) error
+	GetForUser(ctx context.Context, userID uint, limit int) ([]models.AuditLogRecord, error)
+	Find(ctx context.Context, filter rdto.AuditLogFilter) ([]models.AuditLogRecord, error)
+}
+
+type PersonalAccessToken interface {
+	Create(ctx context.Context, token rdto.PersonalAccessTokenCreating) (uint, error)
+	GetByID(ctx context.Context, userID uint, id uint) (*models.PersonalAccessToken, error)
+	GetForUser(ctx context.Context, userID uint) ([]models.PersonalAccessToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*rdto.PersonalAccessTokenWithRole, error)
+	UpdateLastUsed(ctx context.Context, id uint, lastUsedAt time.Time) error
+	Delete(ctx context.Context, userID uint, id uint) error
+}
+
+type TwoFactor interface {
+	GetTOTP(ctx context.Context, userID uint) (*models.UserTOTP, error)
+	SaveTOTP(ctx context.Context, userID uint, secret string) error
+	EnableTOTP(ctx context.Context, userID uint, backupCodeHashes []string) error
+	DeleteTOTP(ctx context.Context, userID uint) error
+	ReplaceBackupCodes(ctx context.Context, userID uint, backupCodeHashes []string) error
+	UseBackupCode(ctx context.Context, userID uint, codeHash string) error
+	CountBackupCodes(ctx context.Context, userID uint) (int, error)
 }
 
 type Repository struct {
 	User
 	University
 	Direction
+	RatingHistory
+	Webhook
+	WebhookDelivery
+	AlertRule
+	UserIdentity
+	AuditLog
+	PersonalAccessToken
+	TwoFactor
 }
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type AccountImpl struct {
+	userRepository          repository.User
+	ratingHistoryRepository repository.RatingHistory
+	passwordResetCache      cache.PasswordReset
+	liveUpdatesCache        cache.LiveUpdates
+	emailVerificationCache  cache.EmailVerification
+	telegramLinkCodeCache   cache.TelegramLinkCode
+	signInAttemptsCache     cache.SignInAttempts
+	twoFactorCache          cache.TwoFactor
+	authorizationService    Authorization
+	userService             User
+	universityService       University
+	directionService        Direction
+	alertRuleService        AlertRule
+	webhookService          Webhook
+	oidcService             OIDC
+	auditLogService         AuditLog
+	snils                   *encryption.Snils
+	logger                  *logging.Logger
+}
+
+func NewAccountImpl(
+	userRepository repository.User,
+	ratingHistoryRepository repository.RatingHistory,
+	passwordResetCache cache.PasswordReset,
+	liveUpdatesCache cache.LiveUpdates,
+	emailVerificationCache cache.EmailVerification,
+	telegramLinkCodeCache cache.TelegramLinkCode,
+	signInAttemptsCache cache.SignInAttempts,
+	twoFactorCache cache.TwoFactor,
+	authorizationService Authorization,
+	userService User,
+	universityService University,
+	directionService Direction,
+	alertRuleService AlertRule,
+	webhookService Webhook,
+	oidcService OIDC,
+	auditLogService AuditLog,
+	snils *encryption.Snils,
+) *AccountImpl {
+	return &AccountImpl{
+		userRepository:          userRepository,
+		ratingHistoryRepository: ratingHistoryRepository,
+		passwordResetCache:      passwordResetCache,
+		liveUpdatesCache:        liveUpdatesCache,
+		emailVerificationCache:  emailVerificationCache,
+		telegramLinkCodeCache:   telegramLinkCodeCache,
+		signInAttemptsCache:     signInAttemptsCache,
+		twoFactorCache:          twoFactorCache,
+		authorizationService:    authorizationService,
+		userService:             userService,
+		universityService:       universityService,
+		directionService:        directionService,
+		alertRuleService:        alertRuleService,
+		webhookService:          webhookService,
+		oidcService:             oidcService,
+		auditLogService:         auditLogService,
+		snils:                   snils,
+		logger:                  logging.NewLogger("account services"),
+	}
+}
+
+// Export collects all personal data of user: profile with notification settings and full
+// SNILS instead of its mask, tracked universities and directions, rating history, alert
+// rules, webhooks, linked identities, active sessions and audit log. Export is the only
+// place besides parsing where SNILS is decrypted: user has right to get stored personal
+// data as is, and export is available only to user authenticated by access token.
+func (s *AccountImpl) Export(ctx context.Context, userID uint, client dto.ClientInfo) (*dto.UserExport, error) {
+	profile, err := s.userService.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	userSnils, err := s.userRepository.GetSnils(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user snils by repository: %w", err)
+	}
+
+	profile.Snils, err = s.snils.Decrypt(userID, userSnils.Snils)
+	if err != nil {
+		return nil, err
+	}
+
+	universities, err := s.universityService.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user universities: %w", err)
+	}
+
+	directions, err := s.directionService.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user directions: %w", err)
+	}
+
+	records, err := s.ratingHistoryRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rating history by repository: %w", err)
+	}
+
+	ratingHistory := make([]dto.ExportedRatingHistoryRecord, len(records))
+	for i, r := range records {
+		ratingHistory[i] = dto.NewExportedRatingHistoryRecord(r)
+	}
+
+	alertRules, err := s.alertRuleService.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user alert rules: %w", err)
+	}
+
+	webhooks, err := s.webhookService.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user webhooks: %w", err)
+	}
+
+	identities, err := s.oidcService.GetIdentities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.authorizationService.GetSessions(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog, err := s.auditLogService.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserExported, client, nil)
+
+	return &dto.UserExport{
+		Profile:       *profile,
+		Universities:  universities,
+		Directions:    directions,
+		RatingHistory: ratingHistory,
+		AlertRules:    alertRules,
+		Webhooks:      webhooks,
+		Identities:    identities,
+		Sessions:      sessions,
+		AuditLog:      auditLog,
+		ExportedAt:    time.Now(),
+	}, nil
+}
+
+// Delete deletes user with all related rows. User sessions are revoked before, so issued
+// tokens can't be used anymore, and cached user data is purged.
+func (s *AccountImpl) Delete(ctx context.Context, userID uint, client dto.ClientInfo) error {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error while getting user by id: %w", err)
+	}
+
+	if err := s.authorizationService.RevokeSessions(ctx, userID); err != nil {
+		return fmt.Errorf("error while revoking user sessions: %w", err)
+	}
+
+	if err := s.userRepository.Delete(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting user by repository: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserDeleted, client, nil)
+
+	return s.purgeCache(ctx, userID, user.Username)
+}
+
+// purgeCache deletes tokens, codes and counters of user kept in cache.
+func (s *AccountImpl) purgeCache(ctx context.Context, userID uint, username string) error {
+	if err := s.passwordResetCache.DeleteForUser(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting password reset token from cache: %w", err)
+	}
+
+	if err := s.liveUpdatesCache.DeleteLatest(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting latest live update from cache: %w", err)
+	}
+
+	if err := s.emailVerificationCache.DeleteForUser(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting email verifications from cache: %w", err)
+	}
+
+	if err := s.telegramLinkCodeCache.DeleteForUser(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting telegram link codes from cache: %w", err)
+	}
+
+	if err := s.signInAttemptsCache.DeleteForUsername(ctx, username); err != nil {
+		return fmt.Errorf("error while deleting sign in attempts from cache: %w", err)
+	}
+
+	if err := s.twoFactorCache.DeleteForUser(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting two factor challenges and codes from cache: %w", err)
+	}
+
+	return nil
+}
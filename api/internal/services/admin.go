@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type AdminImpl struct {
+	userRepository       repository.User
+	universityRepository repository.University
+	directionRepository  repository.Direction
+	ratingListCache      cache.RatingList
+	authorizationService Authorization
+	auditLogService      AuditLog
+	logger               *logging.Logger
+}
+
+func NewAdminImpl(
+	userRepository repository.User,
+	universityRepository repository.University,
+	directionRepository repository.Direction,
+	ratingListCache cache.RatingList,
+	authorizationService Authorization,
+	auditLogService AuditLog,
+) *AdminImpl {
+	return &AdminImpl{
+		userRepository:       userRepository,
+		universityRepository: universityRepository,
+		directionRepository:  directionRepository,
+		ratingListCache:      ratingListCache,
+		authorizationService: authorizationService,
+		auditLogService:      auditLogService,
+		logger:               logging.NewLogger("admin services"),
+	}
+}
+
+// SetRole changes role of user and revokes user sessions, so tokens with the previous
+// role can't be used anymore. Actions of admins are recorded to audit log by admin id.
+func (s *AdminImpl) SetRole(
+	ctx context.Context,
+	adminID uint,
+	userID uint,
+	data dto.RoleSetting,
+	client dto.ClientInfo,
+) error {
+	if err := s.userRepository.SetRole(ctx, userID, data.Role); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return UserNotFoundError
+		}
+
+		return fmt.Errorf("error while setting user role by repository: %w", err)
+	}
+
+	if err := s.authorizationService.RevokeSessions(ctx, userID); err != nil {
+		return fmt.Errorf("error while revoking user sessions: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, adminID, auditActionAdminRoleSet, client, map[string]string{
+		"user_id": strconv.FormatUint(uint64(userID), 10),
+		"role":    data.Role,
+	})
+
+	return nil
+}
+
+func (s *AdminImpl) PatchUniversity(
+	ctx context.Context, adminID uint, id uint, data dto.UniversityPatching, client dto.ClientInfo,
+) error {
+	if err := s.universityRepository.Patch(ctx, id, rdto.UniversityPatching(data)); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return UniversityNotFoundError
+		}
+
+		return fmt.Errorf("error while patching university by repository: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, adminID, auditActionAdminUniversityPatch, client, map[string]string{
+		"university_id": strconv.FormatUint(uint64(id), 10),
+		"fields": changedFields(map[string]bool{
+			"name":                data.Name != nil,
+			"full_name":           data.FullName != nil,
+			"directions_page_url": data.DirectionsPageURL != nil,
+		}),
+	})
+
+	return nil
+}
+
+func (s *AdminImpl) PatchDirection(
+	ctx context.Context,
+	adminID uint,
+	id uint,
+	data dto.DirectionPatching,
+	client dto.ClientInfo,
+) error {
+	if err := s.directionRepository.Patch(ctx, id, rdto.DirectionPatching(data)); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return DirectionNotFoundError
+		}
+
+		return fmt.Errorf("error while patching direction by repository: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, adminID, auditActionAdminDirectionPatch, client, map[string]string{
+		"direction_id": strconv.FormatUint(uint64(id), 10),
+		"fields":       changedFields(map[string]bool{"name": data.Name != nil, "url": data.URL != nil}),
+	})
+
+	return nil
+}
+
+func (s *AdminImpl) PurgeRatingLists(
+	ctx context.Context,
+	adminID uint,
+	client dto.ClientInfo,
+) (*dto.CachePurged, error) {
+	deleted, err := s.ratingListCache.Purge(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while purging rating lists cache: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, adminID, auditActionAdminCachePurged, client, map[string]string{
+		"deleted": strconv.FormatInt(deleted, 10),
+	})
+
+	return &dto.CachePurged{Deleted: deleted}, nil
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type AlertRuleImpl struct {
+	alertRuleRepository repository.AlertRule
+	directionRepository repository.Direction
+	logger              *logging.Logger
+}
+
+func NewAlertRuleImpl(
+	alertRuleRepository repository.AlertRule,
+	directionRepository repository.Direction,
+) *AlertRuleImpl {
+	return &AlertRuleImpl{
+		alertRuleRepository: alertRuleRepository,
+		directionRepository: directionRepository,
+		logger:              logging.NewLogger("alert rule services"),
+	}
+}
+
+func (s *AlertRuleImpl) Create(ctx context.Context, userID uint, data dto.AlertRuleCreating) (*dto.AlertRule, error) {
+	if err := s.checkDirectionTracked(ctx, userID, data.DirectionID); err != nil {
+		return nil, err
+	}
+
+	id, err := s.alertRuleRepository.Create(ctx, rdto.AlertRuleCreating{
+		UserID:      userID,
+		DirectionID: data.DirectionID,
+		Metric:      data.Metric,
+		Operator:    data.Operator,
+		Threshold:   data.Threshold,
+		Cooldown:    data.Cooldown,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while creating alert rule by repository: %w", err)
+	}
+
+	return s.Get(ctx, userID, id)
+}
+
+func (s *AlertRuleImpl) Get(ctx context.Context, userID uint, id uint) (*dto.AlertRule, error) {
+	rule, err := s.alertRuleRepository.GetByID(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, AlertRuleNotFoundError
+		}
+
+		return nil, fmt.Errorf("error while getting alert rule by repository: %w", err)
+	}
+
+	result := dto.NewAlertRule(*rule)
+
+	return &result, nil
+}
+
+func (s *AlertRuleImpl) GetForUser(ctx context.Context, userID uint) ([]dto.AlertRule, error) {
+	rules, err := s.alertRuleRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user alert rules by repository: %w", err)
+	}
+
+	result := make([]dto.AlertRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, dto.NewAlertRule(r))
+	}
+
+	return result, nil
+}
+
+func (s *AlertRuleImpl) Update(
+	ctx context.Context,
+	userID uint,
+	id uint,
+	data dto.AlertRuleUpdating,
+) (*dto.AlertRule, error) {
+	if err := s.alertRuleRepository.Update(ctx, userID, id, rdto.AlertRuleUpdating(data)); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, AlertRuleNotFoundError
+		}
+
+		return nil, fmt.Errorf("error while updating alert rule by repository: %w", err)
+	}
+
+	return s.Get(ctx, userID, id)
+}
+
+func (s *AlertRuleImpl) Delete(ctx context.Context, userID uint, id uint) error {
+	if err := s.alertRuleRepository.Delete(ctx, userID, id); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return AlertRuleNotFoundError
+		}
+
+		return fmt.Errorf("error while deleting alert rule by repository: %w", err)
+	}
+
+	return nil
+}
+
+// Evaluate returns alerts of user direction rules whose condition became true
+// with the current parsing result. Rules on cooldown are skipped.
+func (s *AlertRuleImpl) Evaluate(
+	ctx context.Context, userID uint,
+	directionID uint,
+	previous dto.ParsingResult,
+	current dto.ParsingResult,
+) ([]dto.TriggeredAlert, error) {
+	rules, err := s.alertRuleRepository.GetForDirection(ctx, userID, directionID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting direction alert rules by repository: %w", err)
+	}
+
+	alerts := make([]dto.TriggeredAlert, 0)
+
+	for _, rule := range rules {
+		value, ok := evaluateAlertRule(rule, previous, current)
+		if !ok {
+			continue
+		}
+
+		triggered, err := s.alertRuleRepository.MarkTriggered(ctx, rule.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error while marking alert rule triggered by repository: %w", err)
+		}
+
+		if !triggered {
+			continue
+		}
+
+		alerts = append(alerts, dto.TriggeredAlert{
+			RuleID:    rule.ID,
+			Metric:    rule.Metric,
+			Operator:  rule.Operator,
+			Threshold: rule.Threshold,
+			Value:     value,
+		})
+	}
+
+	return alerts, nil
+}
+
+func (s *AlertRuleImpl) checkDirectionTracked(ctx context.Context, userID uint, directionID uint) error {
+	directions, err := s.directionRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error while getting user directions by repository: %w", err)
+	}
+
+	for _, d := range directions {
+		if d.DirectionID == directionID {
+			return nil
+		}
+	}
+
+	return AlertRuleDirectionNotTrackedError
+}
+
+// evaluateAlertRule returns metric value and reports whether rule condition is met.
+// Conditions on state metrics are met only when they become true, so alert isn't
+// repeated after every parse while condition holds.
+func evaluateAlertRule(rule models.AlertRule, previous dto.ParsingResult, current dto.ParsingResult) (int, bool) {
+	if rule.Metric == dto.PositionChangeMetric {
+		value := int(current.Position) - int(previous.Position)
+		if value < 0 {
+			value = -value
+		}
+
+		return value, compareAlertValue(rule.Operator, value, rule.Threshold)
+	}
+
+	value := getAlertMetricValue(rule.Metric, current)
+	if !compareAlertValue(rule.Operator, value, rule.Threshold) {
+		return value, false
+	}
+
+	return value, !compareAlertValue(rule.Operator, getAlertMetricValue(rule.Metric, previous), rule.Threshold)
+}
+
+func getAlertMetricValue(metric string, r dto.ParsingResult) int {
+	switch metric {
+	case dto.PositionMetric:
+		return int(r.Position)
+	case dto.SubmittedConsentUpperMetric:
+		return int(r.SubmittedConsentUpper)
+	case dto.PriorityOneUpperMetric:
+		return int(r.PriorityOneUpper)
+	case dto.WithinBudgetMetric:
+		if r.IsWithinBudget() {
+			return 1
+		}
+
+		return 0
+	default:
+		return 0
+	}
+}
+
+func compareAlertValue(operator string, value int, threshold int) bool {
+	switch operator {
+	case dto.GreaterOperator:
+		return value > threshold
+	case dto.GreaterOrEqualOperator:
+		return value >= threshold
+	case dto.LessOperator:
+		return value < threshold
+	case dto.LessOrEqualOperator:
+		return value <= threshold
+	case dto.EqualOperator:
+		return value == threshold
+	case dto.NotEqualOperator:
+		return value != threshold
+	default:
+		return false
+	}
+}
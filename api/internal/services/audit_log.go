@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+// Actions of audit log records.
+const (
+	auditActionUserSignedUp          = "user.signed_up"
+	auditActionUserSignedIn          = "user.signed_in"
+	auditActionUserSignInFailed      = "user.sign_in_failed"
+	auditActionUserTokensRefreshed   = "user.tokens_refreshed"
+	auditActionUserRefreshTokenReuse = "user.refresh_token_reused"
+	auditActionUserLoggedOut         = "user.logged_out"
+	auditActionUserPasswordChanged   = "user.password_changed"
+	auditActionUserProfileUpdated    = "user.profile_updated"
+	auditActionUserSnilsChanged      = "user.snils_changed"
+	auditActionUserDirectionsChanged = "user.directions_changed"
+	auditActionUserDirectionAdded    = "user.direction_added"
+	auditActionUserDirectionRemoved  = "user.direction_removed"
+	auditActionUserExported          = "user.exported"
+	auditActionUserDeleted           = "user.deleted"
+	auditActionAdminRoleSet          = "admin.role_set"
+	auditActionAdminUniversityPatch  = "admin.university_patched"
+	auditActionAdminDirectionPatch   = "admin.direction_patched"
+	auditActionAdminCachePurged      = "admin.rating_lists_purged"
+)
+
+// Sign in methods and reasons of failed sign in attempts in audit log records metadata.
+const (
+	signInMethodPassword       = "password"
+	signInMethodTwoFactor      = "two_factor"
+	signInMethodOIDC           = "oidc"
+	signInFailureCredentials   = "invalid_credentials"
+	signInFailureTwoFactorCode = "invalid_two_factor_code"
+)
+
+const (
+	userAuditLogLimit         = 50
+	auditLogQueryDefaultLimit = 100
+)
+
+type AuditLogImpl struct {
+	auditLogRepository repository.AuditLog
+	logger             *logging.Logger
+}
+
+func NewAuditLogImpl(auditLogRepository repository.AuditLog) *AuditLogImpl {
+	return &AuditLogImpl{
+		auditLogRepository: auditLogRepository,
+		logger:             logging.NewLogger("audit log services"),
+	}
+}
+
+// Record records event of user, user id is zero if user is unknown (e.g. failed sign in
+// with unknown username). Failed record doesn't fail the action, it is only logged.
+func (s *AuditLogImpl) Record(
+	ctx context.Context,
+	userID uint,
+	action string,
+	client dto.ClientInfo,
+	metadata map[string]string,
+) {
+	if err := s.auditLogRepository.Create(ctx, rdto.AuditLogCreating{
+		UserID:    userID,
+		Action:    action,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		RequestID: client.RequestID,
+		Metadata:  metadata,
+	}); err != nil {
+		s.logger.Error(err)
+	}
+}
+
+// GetForUser returns the latest security events of user.
+func (s *AuditLogImpl) GetForUser(ctx context.Context, userID uint) ([]dto.AuditLogRecord, error) {
+	records, err := s.auditLogRepository.GetForUser(ctx, userID, userAuditLogLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user audit log by repository: %w", err)
+	}
+
+	result := make([]dto.AuditLogRecord, 0, len(records))
+	for _, r := range records {
+		result = append(result, dto.NewAuditLogRecord(r))
+	}
+
+	return result, nil
+}
+
+// Find returns records of all users matching query.
+func (s *AuditLogImpl) Find(ctx context.Context, query dto.AuditLogQuery) ([]dto.AuditLogRecord, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = auditLogQueryDefaultLimit
+	}
+
+	records, err := s.auditLogRepository.Find(ctx, rdto.AuditLogFilter{
+		UserID: query.UserID,
+		Action: query.Action,
+		From:   query.From,
+		To:     query.To,
+		Limit:  limit,
+		Offset: query.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while finding audit log records by repository: %w", err)
+	}
+
+	result := make([]dto.AuditLogRecord, 0, len(records))
+	for _, r := range records {
+		result = append(result, dto.NewAuditLogRecord(r))
+	}
+
+	return result, nil
+}
+
+// changedFields returns sorted comma separated names of fields set by patch. Values aren't
+// recorded, so personal data doesn't get to audit log.
+func changedFields(fields map[string]bool) string {
+	names := make([]string, 0, len(fields))
+
+	for name, changed := range fields {
+		if changed {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
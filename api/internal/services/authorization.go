@@ -1,8 +1,10 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"sort"
 	"time"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
@@ -12,134 +14,560 @@ import (
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const (
+	sessionIDLength      = 16
+	challengeTokenLength = 32
 )
 
 type AuthorizationImpl struct {
-	userRepository    repository.User
-	refreshTokenCache cache.RefreshToken
-	blacklistCache    cache.Blacklist
-	logger            *logging.Logger
+	userRepository      repository.User
+	sessionCache        cache.Session
+	blacklistCache      cache.Blacklist
+	signInAttemptsCache cache.SignInAttempts
+	twoFactorCache      cache.TwoFactor
+	twoFactorService    TwoFactor
+	auditLogService     AuditLog
+	tokens              *authorization.JWT
+	snils               *encryption.Snils
+	tokensConfig        *config.AuthTokens
+	signInConfig        *config.SignIn
+	twoFactorConfig     *config.TwoFactor
+	logger              *logging.Logger
 }
 
 func NewAuthorizationImpl(
 	userRepository repository.User,
-	refreshTokenCache cache.RefreshToken,
+	sessionCache cache.Session,
 	blacklistCache cache.Blacklist,
+	signInAttemptsCache cache.SignInAttempts,
+	twoFactorCache cache.TwoFactor,
+	twoFactorService TwoFactor,
+	auditLogService AuditLog,
+	tokens *authorization.JWT,
+	snils *encryption.Snils,
 ) *AuthorizationImpl {
 	return &AuthorizationImpl{
-		userRepository:    userRepository,
-		refreshTokenCache: refreshTokenCache,
-		blacklistCache:    blacklistCache,
-		logger:            logging.NewLogger("authorization services"),
+		userRepository:      userRepository,
+		sessionCache:        sessionCache,
+		blacklistCache:      blacklistCache,
+		signInAttemptsCache: signInAttemptsCache,
+		twoFactorCache:      twoFactorCache,
+		twoFactorService:    twoFactorService,
+		auditLogService:     auditLogService,
+		tokens:              tokens,
+		snils:               snils,
+		tokensConfig:        config.Get().AuthTokens,
+		signInConfig:        config.Get().SignIn,
+		twoFactorConfig:     config.Get().TwoFactor,
+		logger:              logging.NewLogger("authorization services"),
 	}
 }
 
-func (s *AuthorizationImpl) SignUpUser(userData dto.SigningUp) (uint, error) {
+// SignUpUser creates user with encrypted SNILS. SNILS can belong only to one user,
+// it is checked by blind index, so SNILS isn't decrypted.
+func (s *AuthorizationImpl) SignUpUser(
+	ctx context.Context,
+	userData dto.SigningUp,
+	client dto.ClientInfo,
+) (uint, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(userData.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return 0, fmt.Errorf("error while crypting password: %w", err)
 	}
 
-	userData.Password = string(hashedPassword)
+	_, err = s.userRepository.GetIDBySnilsHash(ctx, s.snils.Hash(userData.Snils))
+	if err == nil {
+		return 0, SnilsAlreadyUsedError
+	}
+
+	if !errors.Is(err, repository.ErrRecordNotFound) {
+		return 0, fmt.Errorf("error while getting user by snils hash by repository: %w", err)
+	}
+
+	id, err := s.userRepository.NewID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error while reserving user id by repository: %w", err)
+	}
 
-	id, err := s.userRepository.Create(rdto.UserCreating(userData))
+	snils, err := s.snils.Encrypt(id, userData.Snils)
 	if err != nil {
-		s.logger.Error(err)
+		return 0, err
+	}
 
-		return 0, UserAlreadyExistsError
+	if err := s.userRepository.Create(ctx, id, rdto.UserCreating{
+		Username:   userData.Username,
+		Password:   string(hashedPassword),
+		FirstName:  userData.FirstName,
+		MiddleName: userData.MiddleName,
+		LastName:   userData.LastName,
+		Snils:      snils.Ciphertext,
+		SnilsHash:  snils.Hash,
+		SnilsMask:  snils.Mask,
+	}); err != nil {
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			return 0, UserAlreadyExistsError
+		}
+
+		return 0, fmt.Errorf("error while creating user by repository: %w", err)
 	}
 
+	s.auditLogService.Record(ctx, id, auditActionUserSignedUp, client, nil)
+
 	return id, nil
 }
 
-func (s *AuthorizationImpl) GenerateTokens(userCredentials dto.UserCredentials) (*dto.AuthorizationTokens, error) {
-	user, err := s.userRepository.GetUserByUsername(userCredentials.Username)
+// GenerateTokens signs in user by credentials. Attempts are throttled by ip and username,
+// failed attempts of unknown usernames are counted too, so they don't differ from known ones.
+// If user has two-factor authentication enabled, challenge is returned instead of tokens and
+// failed attempts aren't reset until code is verified by SignInTwoFactor.
+func (s *AuthorizationImpl) GenerateTokens(
+	ctx context.Context, userCredentials dto.UserCredentials, client dto.ClientInfo,
+) (*dto.SignInResult, error) {
+	if err := s.checkSignInThrottling(ctx, userCredentials.Username, client.IP); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUserByUsername(ctx, userCredentials.Username)
 	if err != nil {
-		return nil, InvalidUsernameOrPasswordError
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, s.failSignIn(ctx, userCredentials.Username, 0, client)
+		}
+
+		return nil, fmt.Errorf("error while getting user by repository: %w", err)
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(userCredentials.Password)); err != nil {
-		return nil, InvalidUsernameOrPasswordError
+		return nil, s.failSignIn(ctx, userCredentials.Username, user.ID, client)
+	}
+
+	result, err := s.completeSignIn(ctx, user, client)
+	if err != nil || result.Challenge != nil {
+		return result, err
+	}
+
+	if err := s.signInAttemptsCache.ResetFailures(ctx, user.Username); err != nil {
+		s.logger.Error(err)
 	}
 
-	if err := s.blacklistCache.Delete(user.ID); err != nil {
-		return nil, fmt.Errorf("error while deleting user from cache blacklist: %w", err)
+	s.auditLogService.Record(ctx, user.ID, auditActionUserSignedIn, client, map[string]string{
+		"method": signInMethodPassword,
+	})
+
+	return result, nil
+}
+
+// completeSignIn returns two-factor challenge if user has it enabled, otherwise new session is started.
+func (s *AuthorizationImpl) completeSignIn(
+	ctx context.Context,
+	user *models.User,
+	client dto.ClientInfo,
+) (*dto.SignInResult, error) {
+	twoFactorEnabled, err := s.twoFactorService.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactorEnabled {
+		challenge, err := s.createTwoFactorChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dto.SignInResult{Challenge: challenge}, nil
+	}
+
+	tokens, err := s.createSession(ctx, user, client)
+	if err != nil {
+		return nil, err
 	}
 
-	latestRefreshToken, errGettingLatestRefreshToken := s.refreshTokenCache.Get(user.ID)
-	_, errParsingLatestRefreshToken := authorization.ParseToken(
-		latestRefreshToken, config.Get().AuthTokens.RefreshToken,
-	)
+	return &dto.SignInResult{Tokens: tokens}, nil
+}
+
+// SignInTwoFactor finishes sign in by challenge token and TOTP or backup code. Challenge
+// accepts limited count of codes, failed codes are counted as failed sign in attempts too.
+func (s *AuthorizationImpl) SignInTwoFactor(
+	ctx context.Context, data dto.TwoFactorSigningIn, client dto.ClientInfo,
+) (*dto.AuthorizationTokens, error) {
+	challengeHash := hashToken(data.ChallengeToken)
+
+	userID, attempts, err := s.twoFactorCache.AttemptChallenge(ctx, challengeHash)
+	if err != nil {
+		s.logger.Error(err)
 
-	if errGettingLatestRefreshToken != nil || errParsingLatestRefreshToken != nil {
-		return s.generateTokensAndSaveRefreshToken(user.ID)
+		return nil, InvalidTwoFactorChallengeError
 	}
 
-	accessToken, err := authorization.GenerateTokenFromPayload(user.ID, config.Get().AuthTokens.AccessToken)
+	if attempts > int64(s.twoFactorConfig.ChallengeAttempts) {
+		if err := s.twoFactorCache.DeleteChallenge(ctx, challengeHash); err != nil {
+			s.logger.Error(err)
+		}
+
+		return nil, InvalidTwoFactorChallengeError
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("error while generating token: %w", err)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, InvalidTwoFactorChallengeError
+		}
+
+		return nil, fmt.Errorf("error while getting user by repository: %w", err)
 	}
 
-	return &dto.AuthorizationTokens{
-		RefreshToken: latestRefreshToken,
-		AccessToken:  accessToken,
+	if err := s.checkSignInThrottling(ctx, user.Username, client.IP); err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorService.VerifyCode(ctx, user.ID, data.Code); err != nil {
+		if errors.Is(err, InvalidTwoFactorCodeError) {
+			if err := s.registerSignInFailure(ctx, user.Username); err != nil {
+				s.logger.Error(err)
+			}
+
+			s.auditLogService.Record(ctx, user.ID, auditActionUserSignInFailed, client, map[string]string{
+				"username": user.Username,
+				"reason":   signInFailureTwoFactorCode,
+			})
+
+			return nil, InvalidSignInTwoFactorCodeError
+		}
+
+		return nil, err
+	}
+
+	if err := s.twoFactorCache.DeleteChallenge(ctx, challengeHash); err != nil {
+		s.logger.Error(err)
+	}
+
+	if err := s.signInAttemptsCache.ResetFailures(ctx, user.Username); err != nil {
+		s.logger.Error(err)
+	}
+
+	tokens, err := s.createSession(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogService.Record(ctx, user.ID, auditActionUserSignedIn, client, map[string]string{
+		"method": signInMethodTwoFactor,
+	})
+
+	return tokens, nil
+}
+
+func (s *AuthorizationImpl) createTwoFactorChallenge(
+	ctx context.Context,
+	userID uint,
+) (*dto.TwoFactorChallenge, error) {
+	token, err := random.Hex(challengeTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating two factor challenge token: %w", err)
+	}
+
+	if err := s.twoFactorCache.SaveChallenge(ctx, hashToken(token), userID, s.twoFactorConfig.ChallengeTTL); err != nil {
+		return nil, err
+	}
+
+	return &dto.TwoFactorChallenge{
+		ChallengeToken: token,
+		ExpiresIn:      int(s.twoFactorConfig.ChallengeTTL.Seconds()),
 	}, nil
 }
 
-func (s *AuthorizationImpl) RefreshTokens(refreshToken string) (*dto.AuthorizationTokens, error) {
-	tokenClaims, err := authorization.ParseToken(refreshToken, config.Get().AuthTokens.RefreshToken)
+// failSignIn counts failed attempt of username, userID is zero for unknown usernames.
+func (s *AuthorizationImpl) failSignIn(ctx context.Context, username string, userID uint, client dto.ClientInfo) error {
+	if err := s.registerSignInFailure(ctx, username); err != nil {
+		s.logger.Error(err)
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserSignInFailed, client, map[string]string{
+		"username": username,
+		"reason":   signInFailureCredentials,
+	})
+
+	return InvalidUsernameOrPasswordError
+}
+
+// GenerateTokensForUser signs in already authenticated user, e.g. with external identity
+// provider. Sign in is throttled and requires two-factor code as sign in by credentials does.
+func (s *AuthorizationImpl) GenerateTokensForUser(
+	ctx context.Context, userID uint, client dto.ClientInfo,
+) (*dto.SignInResult, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
 	if err != nil {
-		return nil, InvalidTokenError
+		return nil, fmt.Errorf("error while getting user by id: %w", err)
 	}
 
-	savedRefreshToken, err := s.refreshTokenCache.Get(tokenClaims.UserID)
+	if err := s.checkSignInThrottling(ctx, user.Username, client.IP); err != nil {
+		return nil, err
+	}
+
+	return s.completeSignIn(ctx, user, client)
+}
+
+func (s *AuthorizationImpl) createSession(
+	ctx context.Context,
+	user *models.User,
+	client dto.ClientInfo,
+) (*dto.AuthorizationTokens, error) {
+	sessionID, err := random.Hex(sessionIDLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating session id: %w", err)
+	}
+
+	tokens, err := s.tokens.GenerateTokens(user.ID, sessionID, user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating tokens: %w", err)
+	}
+
+	now := time.Now()
+	if err := s.sessionCache.Save(ctx, models.Session{
+		ID:                   sessionID,
+		UserID:               user.ID,
+		RefreshTokenID:       tokens.RefreshTokenID,
+		AccessTokenID:        tokens.AccessTokenID,
+		AccessTokenExpiresAt: now.Add(s.tokensConfig.AccessToken.TTL),
+		IP:                   client.IP,
+		UserAgent:            client.UserAgent,
+		CreatedAt:            now,
+		LastUsedAt:           now,
+	}, s.tokensConfig.RefreshToken.TTL); err != nil {
+		return nil, fmt.Errorf("error while saving session in cache: %w", err)
+	}
+
+	return dto.NewAuthorizationTokens(tokens.AccessToken, tokens.RefreshToken), nil
+}
+
+// RefreshTokens rotates refresh token of session. Refresh token can be used only once,
+// so if already rotated token is presented, it is considered stolen and the whole
+// session is revoked. Role is read from user again, so role changes apply on refresh.
+func (s *AuthorizationImpl) RefreshTokens(
+	ctx context.Context,
+	refreshToken string,
+	client dto.ClientInfo,
+) (*dto.AuthorizationTokens, error) {
+	tokenClaims, err := s.tokens.ParseRefreshToken(refreshToken)
 	if err != nil {
 		return nil, InvalidTokenError
 	}
 
-	if strings.Compare(savedRefreshToken, refreshToken) != 0 {
+	session, err := s.sessionCache.Get(ctx, tokenClaims.SessionID)
+	if err != nil || session.UserID != tokenClaims.UserID {
 		return nil, InvalidTokenError
 	}
 
-	return s.generateTokensAndSaveRefreshToken(tokenClaims.UserID)
-}
+	user, err := s.userRepository.GetUserByID(ctx, session.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, InvalidTokenError
+		}
+
+		return nil, fmt.Errorf("error while getting user by repository: %w", err)
+	}
 
-func (s *AuthorizationImpl) generateTokensAndSaveRefreshToken(userID uint) (*dto.AuthorizationTokens, error) {
-	tokens, err := authorization.GenerateTokensFromPayload(userID, config.Get().AuthTokens)
+	tokens, err := s.tokens.GenerateTokens(session.UserID, session.ID, user.Role)
 	if err != nil {
 		return nil, fmt.Errorf("error while generating tokens: %w", err)
 	}
 
-	if err := s.refreshTokenCache.Save(
-		userID, tokens.RefreshToken, config.Get().AuthTokens.RefreshToken.TTL,
-	); err != nil {
-		return nil, fmt.Errorf("error while saving refresh token in cache: %w", err)
+	rotatedSession := *session
+	rotatedSession.RefreshTokenID = tokens.RefreshTokenID
+	rotatedSession.AccessTokenID = tokens.AccessTokenID
+	rotatedSession.AccessTokenExpiresAt = time.Now().Add(s.tokensConfig.AccessToken.TTL)
+	rotatedSession.IP = client.IP
+	rotatedSession.UserAgent = client.UserAgent
+	rotatedSession.LastUsedAt = time.Now()
+
+	rotated, err := s.sessionCache.Rotate(ctx, rotatedSession, tokenClaims.Id, s.tokensConfig.RefreshToken.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("error while rotating session refresh token: %w", err)
+	}
+
+	if !rotated {
+		s.logger.Warn(fmt.Errorf("refresh token reuse detected, revoking session %s of user %d", session.ID, session.UserID))
+
+		if err := s.revokeSession(ctx, *session); err != nil {
+			return nil, err
+		}
+
+		s.auditLogService.Record(ctx, session.UserID, auditActionUserRefreshTokenReuse, client, map[string]string{
+			"session_id": session.ID,
+		})
+
+		return nil, RefreshTokenReusedError
 	}
 
-	return (*dto.AuthorizationTokens)(tokens), nil
+	s.auditLogService.Record(ctx, session.UserID, auditActionUserTokensRefreshed, client, map[string]string{
+		"session_id": session.ID,
+	})
+
+	return dto.NewAuthorizationTokens(tokens.AccessToken, tokens.RefreshToken), nil
 }
 
-func (s *AuthorizationImpl) LogoutUser(userID uint, accessToken string) error {
-	tokenClaims, err := authorization.ParseToken(accessToken, config.Get().AuthTokens.AccessToken)
+// LogoutUser revokes access token and its session, other user sessions stay active.
+func (s *AuthorizationImpl) LogoutUser(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+	client dto.ClientInfo,
+) error {
+	tokenClaims, err := s.tokens.ParseAccessToken(accessToken)
 	if err != nil {
 		return InvalidTokenError
 	}
 
-	storageTimeInTheBlacklist := time.Until(time.Unix(tokenClaims.ExpiresAt, 0))
-	if err := s.blacklistCache.Save(userID, accessToken, storageTimeInTheBlacklist); err != nil {
-		return fmt.Errorf("error while adding user to cahce blacklist: %w", err)
+	if err := s.revokeToken(ctx, tokenClaims.Id, time.Unix(tokenClaims.ExpiresAt, 0)); err != nil {
+		return err
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserLoggedOut, client, map[string]string{
+		"session_id": tokenClaims.SessionID,
+	})
+
+	session, err := s.sessionCache.Get(ctx, tokenClaims.SessionID)
+	if err != nil || session.UserID != userID {
+		return nil
+	}
+
+	return s.revokeSession(ctx, *session)
+}
+
+func (s *AuthorizationImpl) GetSessions(
+	ctx context.Context,
+	userID uint,
+	currentSessionID string,
+) ([]dto.Session, error) {
+	sessions, err := s.sessionCache.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user sessions from cache: %w", err)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+
+	result := make([]dto.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, dto.NewSession(session, currentSessionID))
+	}
+
+	return result, nil
+}
+
+func (s *AuthorizationImpl) RevokeSession(ctx context.Context, userID uint, sessionID string) error {
+	session, err := s.sessionCache.Get(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return SessionNotFoundError
+	}
+
+	return s.revokeSession(ctx, *session)
+}
+
+func (s *AuthorizationImpl) RevokeSessions(ctx context.Context, userID uint) error {
+	sessions, err := s.sessionCache.GetForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error while getting user sessions from cache: %w", err)
+	}
+
+	for _, session := range sessions {
+		if err := s.revokeToken(ctx, session.AccessTokenID, session.AccessTokenExpiresAt); err != nil {
+			return err
+		}
 	}
 
-	if err := s.refreshTokenCache.Delete(userID); err != nil {
-		return fmt.Errorf("error while deleting user refresh token from cache: %w", err)
+	if err := s.sessionCache.DeleteForUser(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting user sessions from cache: %w", err)
 	}
 
 	return nil
 }
 
-func (s *AuthorizationImpl) IsUserLogout(userID uint) bool {
-	return s.blacklistCache.Get(userID) != nil
+// ChangePassword sets new password if current one is correct and revokes all user
+// sessions, so other devices have to sign in again.
+func (s *AuthorizationImpl) ChangePassword(
+	ctx context.Context,
+	userID uint,
+	data dto.PasswordChanging,
+	client dto.ClientInfo,
+) error {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error while getting user by repository: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(data.CurrentPassword)); err != nil {
+		return InvalidPasswordError
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(data.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("error while crypting password: %w", err)
+	}
+
+	if err := s.userRepository.UpdatePassword(ctx, userID, string(hashedPassword)); err != nil {
+		return fmt.Errorf("error while updating user password by repository: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserPasswordChanged, client, nil)
+
+	return s.RevokeSessions(ctx, userID)
+}
+
+func (s *AuthorizationImpl) ParseAccessToken(accessToken string) (*authorization.TokenClaims, error) {
+	tokenClaims, err := s.tokens.ParseAccessToken(accessToken)
+	if err != nil {
+		return nil, InvalidTokenError
+	}
+
+	return tokenClaims, nil
+}
+
+func (s *AuthorizationImpl) GetJWKS() authorization.JWKS {
+	return s.tokens.JWKS()
+}
+
+func (s *AuthorizationImpl) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	revoked, err := s.blacklistCache.Contains(ctx, tokenID)
+	if err != nil {
+		return false, fmt.Errorf("error while checking token in cache blacklist: %w", err)
+	}
+
+	return revoked, nil
+}
+
+// revokeSession deletes session and revokes its latest access token. Access tokens
+// issued by previous refreshes of session stay valid until expiration.
+func (s *AuthorizationImpl) revokeSession(ctx context.Context, session models.Session) error {
+	if err := s.revokeToken(ctx, session.AccessTokenID, session.AccessTokenExpiresAt); err != nil {
+		return err
+	}
+
+	if err := s.sessionCache.Delete(ctx, session.UserID, session.ID); err != nil {
+		return fmt.Errorf("error while deleting user session from cache: %w", err)
+	}
+
+	return nil
+}
+
+// revokeToken adds token to blacklist until its expiration.
+func (s *AuthorizationImpl) revokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if tokenID == "" || ttl <= 0 {
+		return nil
+	}
+
+	if err := s.blacklistCache.Save(ctx, tokenID, ttl); err != nil {
+		return fmt.Errorf("error while adding token to cache blacklist: %w", err)
+	}
+
+	return nil
 }
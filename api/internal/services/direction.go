@@ -1,24 +1,35 @@
 package services
 
 import (
+	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 )
 
+const defaultDirectionsPageLimit = 50
+
 type DirectionImpl struct {
-	directionRepository repository.Direction
-	userRepository      repository.User
-	universityService   University
-	parsingService      Parsing
-	logger              *logging.Logger
+	directionRepository  repository.Direction
+	userRepository       repository.User
+	universityService    University
+	parsingService       Parsing
+	ratingHistoryService RatingHistory
+	liveUpdatesService   LiveUpdates
+	auditLogService      AuditLog
+	snils                *encryption.Snils
+	logger               *logging.Logger
 }
 
 func NewDirectionImpl(
@@ -26,36 +37,126 @@ func NewDirectionImpl(
 	userRepository repository.User,
 	universityService University,
 	parsingService Parsing,
+	ratingHistoryService RatingHistory,
+	liveUpdatesService LiveUpdates,
+	auditLogService AuditLog,
+	snils *encryption.Snils,
 ) *DirectionImpl {
 	return &DirectionImpl{
-		directionRepository: directionRepository,
-		userRepository:      userRepository,
-		universityService:   universityService,
-		parsingService:      parsingService,
-		logger:              logging.NewLogger("directions services"),
+		directionRepository:  directionRepository,
+		userRepository:       userRepository,
+		universityService:    universityService,
+		parsingService:       parsingService,
+		ratingHistoryService: ratingHistoryService,
+		liveUpdatesService:   liveUpdatesService,
+		auditLogService:      auditLogService,
+		snils:                snils,
+		logger:               logging.NewLogger("directions services"),
 	}
 }
 
-func (s *DirectionImpl) GetByID(id uint) (*models.Direction, error) {
-	direction, err := s.directionRepository.GetByID(id)
+func (s *DirectionImpl) GetByID(ctx context.Context, id uint) (*models.Direction, error) {
+	direction, err := s.directionRepository.GetByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, DirectionNotFoundError
+		}
+
 		return nil, fmt.Errorf("error while getting direction by id by repository: %w", err)
 	}
 
 	return direction, nil
 }
 
-func (s *DirectionImpl) GetAll() ([]dto.UniversityDirections, error) {
-	directions, err := s.directionRepository.GetAll()
+// GetAll returns directions catalogue grouped by universities and cursor of the next page,
+// which is empty if there are no more directions. Catalogue isn't paginated without limit.
+func (s *DirectionImpl) GetAll(
+	ctx context.Context,
+	query dto.DirectionQuery,
+) ([]dto.UniversityDirections, string, error) {
+	directions, nextCursor, err := s.find(ctx, query)
 	if err != nil {
-		return nil, fmt.Errorf("error while getting all directions by repository: %w", err)
+		return nil, "", err
 	}
 
-	return s.mapDirectionsToUniversityDirections(directions), nil
+	universityDirections := s.mapDirectionsToUniversityDirections(directions)
+	sortUniversityDirections(universityDirections)
+
+	return universityDirections, nextCursor, nil
 }
 
-func (s *DirectionImpl) GetForUser(userID uint) ([]dto.UniversityDirections, error) {
-	directions, err := s.directionRepository.GetForUser(userID)
+func (s *DirectionImpl) Find(ctx context.Context, query dto.DirectionQuery) (*dto.DirectionsPage, error) {
+	if query.Limit == 0 {
+		query.Limit = defaultDirectionsPageLimit
+	}
+
+	directions, nextCursor, err := s.find(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.DirectionsPage{
+		Directions: mapDirectionsToUniversityDirection(directions),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *DirectionImpl) find(ctx context.Context, query dto.DirectionQuery) ([]rdto.Direction, string, error) {
+	afterID, err := decodeDirectionCursor(query.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter := rdto.DirectionFilter{
+		UniversityID: query.UniversityID,
+		Search:       strings.TrimSpace(query.Search),
+		CodePrefix:   strings.TrimSpace(query.Code),
+		AfterID:      afterID,
+	}
+
+	// one more direction is selected to know whether there is the next page
+	if query.Limit != 0 {
+		filter.Limit = query.Limit + 1
+	}
+
+	directions, err := s.directionRepository.Find(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("error while finding directions by repository: %w", err)
+	}
+
+	if query.Limit == 0 || len(directions) <= query.Limit {
+		return directions, "", nil
+	}
+
+	directions = directions[:query.Limit]
+
+	return directions, encodeDirectionCursor(directions[len(directions)-1].DirectionID), nil
+}
+
+func encodeDirectionCursor(id uint) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(id), 10)))
+}
+
+func decodeDirectionCursor(cursor string) (uint, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, InvalidCursorError
+	}
+
+	id, err := strconv.ParseUint(string(value), 10, 32)
+	if err != nil || id == 0 {
+		return 0, InvalidCursorError
+	}
+
+	return uint(id), nil
+}
+
+func (s *DirectionImpl) GetForUser(ctx context.Context, userID uint) ([]dto.UniversityDirections, error) {
+	directions, err := s.directionRepository.GetForUser(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting user directions by repository: %w", err)
 	}
@@ -117,25 +218,43 @@ func newParsingDirectionResults(directionsCount int) *parsingDirectionResults {
 	}
 }
 
-func (s *DirectionImpl) GetForUserWithRating(userID uint) ([]dto.UniversityDirectionsWithRating, error) {
-	directions, err := s.directionRepository.GetForUser(userID)
+// GetForUserWithRating parses ratings of user directions. SNILS is decrypted here, because
+// it is needed for finding user in rating lists, and besides that only by personal data
+// export. Users without SNILS, e.g. created by OIDC sign in, get SnilsNotSetError instead
+// of parsing.
+func (s *DirectionImpl) GetForUserWithRating(
+	ctx context.Context,
+	userID uint,
+) ([]dto.UniversityDirectionsWithRating, error) {
+	logger := s.logger.WithContext(ctx)
+
+	directions, err := s.directionRepository.GetForUser(ctx, userID)
 	if err != nil {
-		s.logger.Error(err)
+		logger.Error(err)
 
 		return nil, fmt.Errorf("error while getting user directions by repository: %w", err)
 	}
 
-	userSnils, err := s.userRepository.GetSnils(userID)
+	userSnils, err := s.userRepository.GetSnils(ctx, userID)
 	if err != nil {
-		s.logger.Error(err)
+		logger.Error(err)
 
 		return nil, fmt.Errorf("error while getting user snils by repository: %w", err)
 	}
 
+	if userSnils.Snils == "" {
+		return nil, SnilsNotSetError
+	}
+
+	snils, err := s.snils.Decrypt(userID, userSnils.Snils)
+	if err != nil {
+		return nil, err
+	}
+
 	results := newParsingDirectionResults(len(directions))
 	for _, d := range directions {
 		results.wg.Add(1)
-		go s.parseDirectionRating(results, d, userSnils.Snils)
+		go s.parseDirectionRating(ctx, results, d, snils)
 	}
 
 	results.wg.Wait()
@@ -148,13 +267,40 @@ func (s *DirectionImpl) GetForUserWithRating(userID uint) ([]dto.UniversityDirec
 		directionsWithRating[i] = <-results.directionsWithRating
 	}
 
+	if err := s.ratingHistoryService.Track(ctx, userID, directionsWithRating); err != nil {
+		logger.Error(err)
+	}
+
 	universityDirectionsWithRating := s.mapRatingDirectionsToUniversityDirections(directionsWithRating)
 	sortUniversityDirectionsWithRating(universityDirectionsWithRating)
 
+	if err := s.liveUpdatesService.Publish(ctx, userID, universityDirectionsWithRating); err != nil {
+		logger.Error(err)
+	}
+
 	return universityDirectionsWithRating, nil
 }
 
+// RefreshRatings parses ratings of every user tracking directions, so rating changes
+// are noticed even if user doesn't open the dashboard.
+func (s *DirectionImpl) RefreshRatings(ctx context.Context) error {
+	userIDs, err := s.directionRepository.GetTrackingUserIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting users tracking directions by repository: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		userCtx := logging.ContextWithUserID(ctx, userID)
+		if _, err := s.GetForUserWithRating(userCtx, userID); err != nil && !errors.Is(err, SnilsNotSetError) {
+			s.logger.WithContext(userCtx).Error(err)
+		}
+	}
+
+	return nil
+}
+
 func (s *DirectionImpl) parseDirectionRating(
+	ctx context.Context,
 	results *parsingDirectionResults,
 	direction rdto.Direction,
 	userSnils string,
@@ -162,6 +308,7 @@ func (s *DirectionImpl) parseDirectionRating(
 	defer results.wg.Done()
 
 	parsingResult, err := s.parsingService.ParseRating(
+		ctx,
 		direction.UniversityName,
 		direction.DirectionURL,
 		userSnils,
@@ -217,25 +364,151 @@ func sortUniversityDirectionsWithRating(universityDirections []dto.UniversityDir
 	}
 }
 
-func (s *DirectionImpl) SetForUser(userID uint, directionIDs dto.IDs) error {
-	if err := s.directionRepository.Clear(userID); err != nil {
+func (s *DirectionImpl) SetForUser(
+	ctx context.Context,
+	userID uint,
+	directionIDs dto.IDs,
+	client dto.ClientInfo,
+) error {
+	if err := s.directionRepository.Clear(ctx, userID); err != nil {
 		return fmt.Errorf("error while clearing user directions by repository: %w", err)
 	}
 
-	if err := s.directionRepository.SetForUser(userID, directionIDs); err != nil {
+	if err := s.directionRepository.SetForUser(ctx, userID, directionIDs); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return DirectionNotFoundError
+		}
+
 		return fmt.Errorf("error while setting directions for user by repository: %w", err)
 	}
 
-	if err := s.universityService.SetForUser(
-		userID, dto.IDs{IDs: s.getUniversityIDsOfDirections(directionIDs.IDs)},
+	if err := s.universityService.SetForUser(ctx,
+		userID, dto.IDs{IDs: s.getUniversityIDsOfDirections(ctx, directionIDs.IDs)},
 	); err != nil {
 		return fmt.Errorf("error while updating user universities by repository: %w", err)
 	}
 
+	ids := make([]string, 0, len(directionIDs.IDs))
+	for _, id := range directionIDs.IDs {
+		ids = append(ids, strconv.FormatUint(uint64(id), 10))
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserDirectionsChanged, client, map[string]string{
+		"direction_ids": strings.Join(ids, ","),
+	})
+
+	return nil
+}
+
+// GetTrackedForUser returns directions tracked by user ordered by id.
+func (s *DirectionImpl) GetTrackedForUser(ctx context.Context, userID uint) ([]dto.UniversityDirection, error) {
+	directions, err := s.directionRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user directions by repository: %w", err)
+	}
+
+	sort.SliceStable(directions, func(i, j int) bool {
+		return directions[i].DirectionID < directions[j].DirectionID
+	})
+
+	return mapDirectionsToUniversityDirection(directions), nil
+}
+
+func (s *DirectionImpl) GetTrackedForUserByID(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+) (*dto.UniversityDirection, error) {
+	direction, err := s.directionRepository.GetForUserByID(ctx, userID, directionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, DirectionNotTrackedError
+		}
+
+		return nil, fmt.Errorf("error while getting user direction by repository: %w", err)
+	}
+
+	universityDirection := dto.NewUniversityDirection(*direction)
+
+	return &universityDirection, nil
+}
+
+func (s *DirectionImpl) GetForUniversity(ctx context.Context, universityID uint) ([]dto.UniversityDirection, error) {
+	if _, err := s.universityService.GetByID(ctx, universityID); err != nil {
+		return nil, fmt.Errorf("error while getting university: %w", err)
+	}
+
+	return s.GetForUniversities(ctx, []uint{universityID})
+}
+
+func (s *DirectionImpl) GetForUniversities(
+	ctx context.Context,
+	universityIDs []uint,
+) ([]dto.UniversityDirection, error) {
+	directions, err := s.directionRepository.GetForUniversities(ctx, universityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting universities directions by repository: %w", err)
+	}
+
+	return mapDirectionsToUniversityDirection(directions), nil
+}
+
+func mapDirectionsToUniversityDirection(directions []rdto.Direction) []dto.UniversityDirection {
+	result := make([]dto.UniversityDirection, 0, len(directions))
+	for _, d := range directions {
+		result = append(result, dto.NewUniversityDirection(d))
+	}
+
+	return result
+}
+
+// AddForUser adds single direction to user and reports whether it wasn't tracked before.
+// Universities of user are derived from directions by repository.
+func (s *DirectionImpl) AddForUser(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+	client dto.ClientInfo,
+) (bool, error) {
+	if _, err := s.directionRepository.GetByID(ctx, directionID); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return false, DirectionNotFoundError
+		}
+
+		return false, fmt.Errorf("error while getting direction by repository: %w", err)
+	}
+
+	added, err := s.directionRepository.AddForUser(ctx, userID, directionID)
+	if err != nil {
+		return false, fmt.Errorf("error while adding direction to user by repository: %w", err)
+	}
+
+	if added {
+		s.auditLogService.Record(ctx, userID, auditActionUserDirectionAdded, client, map[string]string{
+			"direction_id": strconv.FormatUint(uint64(directionID), 10),
+		})
+	}
+
+	return added, nil
+}
+
+func (s *DirectionImpl) RemoveForUser(ctx context.Context, userID uint, directionID uint, client dto.ClientInfo) error {
+	if err := s.directionRepository.RemoveForUser(ctx, userID, directionID); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return DirectionNotTrackedError
+		}
+
+		return fmt.Errorf("error while removing direction from user by repository: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserDirectionRemoved, client, map[string]string{
+		"direction_id": strconv.FormatUint(uint64(directionID), 10),
+	})
+
 	return nil
 }
 
-func (s *DirectionImpl) getUniversityIDsOfDirections(directionIDs []uint) []uint {
+func (s *DirectionImpl) getUniversityIDsOfDirections(ctx context.Context, directionIDs []uint) []uint {
 	var (
 		universityIDsMap sync.Map
 		wg               sync.WaitGroup
@@ -245,7 +518,7 @@ func (s *DirectionImpl) getUniversityIDsOfDirections(directionIDs []uint) []uint
 		wg.Add(1)
 
 		go func(id uint) {
-			d, _ := s.directionRepository.GetUniversityID(id)
+			d, _ := s.directionRepository.GetUniversityID(ctx, id)
 			universityIDsMap.Store(d.UniversityID, true)
 			wg.Done()
 		}(directionID)
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/mailer"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/signature"
+)
+
+const (
+	emailVerificationTokenLength = 32
+
+	verificationEmailTemplate        = "verification"
+	positionChangedEmailTemplate     = "position_changed"
+	budgetStatusChangedEmailTemplate = "budget_status_changed"
+	alertTriggeredEmailTemplate      = "alert_triggered"
+	passwordResetEmailTemplate       = "password_reset"
+
+	listUnsubscribeHeader = "List-Unsubscribe"
+
+	// Verification and alert emails are limited separately, so alerts don't block verification.
+	emailVerificationRateLimitKey = "email_verification_%d"
+	emailAlertRateLimitKey        = "email_alert_%d"
+)
+
+// ErrEmailLinksSecretNotSet is returned if EMAIL_LINKS_SECRET env isn't set, links of emails
+// couldn't be verified without it.
+var ErrEmailLinksSecretNotSet = errors.New("email links secret isn't set")
+
+type verificationEmailData struct {
+	FirstName       string
+	VerificationURL string
+}
+
+type passwordResetEmailData struct {
+	FirstName string
+	ResetURL  string
+	Token     string
+}
+
+type ratingEmailData struct {
+	FirstName      string
+	Event          dto.RatingEvent
+	UnsubscribeURL string
+}
+
+type EmailImpl struct {
+	userRepository         repository.User
+	emailVerificationCache cache.EmailVerification
+	rateLimitCache         cache.RateLimit
+	mailer                 *mailer.Mailer
+	templates              *mailer.Templates
+	cfg                    *config.Email
+	logger                 *logging.Logger
+}
+
+func NewEmailImpl(
+	userRepository repository.User,
+	emailVerificationCache cache.EmailVerification,
+	rateLimitCache cache.RateLimit,
+	mailer *mailer.Mailer,
+	templates *mailer.Templates,
+) (*EmailImpl, error) {
+	cfg := config.Get().Email
+	if len(cfg.LinksSecret) == 0 {
+		return nil, ErrEmailLinksSecretNotSet
+	}
+
+	return &EmailImpl{
+		userRepository:         userRepository,
+		emailVerificationCache: emailVerificationCache,
+		rateLimitCache:         rateLimitCache,
+		mailer:                 mailer,
+		templates:              templates,
+		cfg:                    cfg,
+		logger:                 logging.NewLogger("email services"),
+	}, nil
+}
+
+// RequestVerification sends email with verification link. Email is set to user
+// only after following the link.
+func (s *EmailImpl) RequestVerification(ctx context.Context, userID uint, data dto.EmailAddress) error {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error while getting user by repository: %w", err)
+	}
+
+	if err := s.checkRateLimit(ctx, emailVerificationRateLimitKey, userID); err != nil {
+		return err
+	}
+
+	token, err := random.Hex(emailVerificationTokenLength)
+	if err != nil {
+		return fmt.Errorf("error while generating verification token: %w", err)
+	}
+
+	if err := s.emailVerificationCache.Save(ctx, token, userID, data.Email, s.cfg.VerificationTTL); err != nil {
+		return fmt.Errorf("error while saving verification token in cache: %w", err)
+	}
+
+	message, err := s.templates.Render(user.Language, verificationEmailTemplate, verificationEmailData{
+		FirstName:       user.FirstName,
+		VerificationURL: s.formatURL("/email/verify", token),
+	})
+	if err != nil {
+		return fmt.Errorf("error while rendering verification email: %w", err)
+	}
+
+	message.To = data.Email
+	if err := s.mailer.Send(*message); err != nil {
+		return fmt.Errorf("error while sending verification email: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EmailImpl) Verify(ctx context.Context, token string) error {
+	userID, email, err := s.emailVerificationCache.Get(ctx, token)
+	if err != nil {
+		s.logger.Error(err)
+
+		return InvalidConfirmationTokenError
+	}
+
+	if err := s.userRepository.SetEmail(ctx, userID, email); err != nil {
+		if errors.Is(err, repository.ErrEmailAlreadyUsed) {
+			return EmailAlreadyUsedError
+		}
+
+		return fmt.Errorf("error while setting user email by repository: %w", err)
+	}
+
+	if err := s.emailVerificationCache.Delete(ctx, token); err != nil {
+		return fmt.Errorf("error while deleting verification token from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EmailImpl) PatchSettings(ctx context.Context, userID uint, data dto.EmailSettings) error {
+	if err := s.userRepository.PatchEmailSettings(ctx, userID, rdto.EmailSettingsPatching(data)); err != nil {
+		return fmt.Errorf("error while patching email settings by repository: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EmailImpl) Unsubscribe(ctx context.Context, token string) error {
+	value, err := signature.VerifyValue(s.cfg.LinksSecret, token)
+	if err != nil {
+		return InvalidConfirmationTokenError
+	}
+
+	userID, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return InvalidConfirmationTokenError
+	}
+
+	emailNotifications := false
+	if err := s.userRepository.PatchEmailSettings(ctx, uint(userID), rdto.EmailSettingsPatching{
+		EmailNotifications: &emailNotifications,
+	}); err != nil {
+		return fmt.Errorf("error while disabling email notifications by repository: %w", err)
+	}
+
+	return nil
+}
+
+// Notify sends position change, budget status and alert rules emails to user verified email.
+// Alerts exceeding user rate limit are dropped.
+func (s *EmailImpl) Notify(ctx context.Context, event dto.RatingEvent) error {
+	templateName := s.getRatingEventTemplate(event)
+	if templateName == "" {
+		return nil
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("error while getting user by repository: %w", err)
+	}
+
+	if !s.isSubscribed(user) {
+		return nil
+	}
+
+	if err := s.checkRateLimit(ctx, emailAlertRateLimitKey, user.ID); err != nil {
+		s.logger.Warn(err)
+
+		return nil
+	}
+
+	unsubscribeURL := s.formatURL("/email/unsubscribe", signature.SignValue(
+		s.cfg.LinksSecret, strconv.FormatUint(uint64(user.ID), 10),
+	))
+
+	message, err := s.templates.Render(user.Language, templateName, ratingEmailData{
+		FirstName:      user.FirstName,
+		Event:          event,
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("error while rendering rating email: %w", err)
+	}
+
+	message.To = user.Email.String
+	message.Headers = map[string]string{listUnsubscribeHeader: fmt.Sprintf("<%s>", unsubscribeURL)}
+
+	if err := s.mailer.Send(*message); err != nil {
+		return fmt.Errorf("error while sending rating email: %w", err)
+	}
+
+	return nil
+}
+
+// SendPasswordReset sends password reset link to user verified email. It reports false
+// when user has no verified email.
+func (s *EmailImpl) SendPasswordReset(
+	ctx context.Context,
+	user *models.User,
+	resetURL string,
+	token string,
+) (bool, error) {
+	if !user.Email.Valid || !user.IsEmailVerified {
+		return false, nil
+	}
+
+	message, err := s.templates.Render(user.Language, passwordResetEmailTemplate, passwordResetEmailData{
+		FirstName: user.FirstName,
+		ResetURL:  resetURL,
+		Token:     token,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error while rendering password reset email: %w", err)
+	}
+
+	message.To = user.Email.String
+	if err := s.mailer.Send(*message); err != nil {
+		return false, fmt.Errorf("error while sending password reset email: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *EmailImpl) getRatingEventTemplate(event dto.RatingEvent) string {
+	if event.Type == dto.AlertTriggeredEvent {
+		return alertTriggeredEmailTemplate
+	}
+
+	templateName := ""
+
+	for _, change := range event.Changes {
+		switch change {
+		case dto.BudgetStatusChange:
+			return budgetStatusChangedEmailTemplate
+		case dto.PositionChange:
+			templateName = positionChangedEmailTemplate
+		}
+	}
+
+	return templateName
+}
+
+func (s *EmailImpl) isSubscribed(user *models.User) bool {
+	return user.Email.Valid && user.IsEmailVerified && user.EmailNotifications
+}
+
+func (s *EmailImpl) checkRateLimit(ctx context.Context, keyFormat string, userID uint) error {
+	allowed, err := s.rateLimitCache.Allow(ctx,
+		fmt.Sprintf(keyFormat, userID), s.cfg.RateLimit, s.cfg.RateLimitWindow,
+	)
+	if err != nil {
+		return fmt.Errorf("error while checking email rate limit: %w", err)
+	}
+
+	if !allowed {
+		return EmailRateLimitExceededError
+	}
+
+	return nil
+}
+
+func (s *EmailImpl) formatURL(path string, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", s.cfg.PublicURL, path, url.QueryEscape(token))
+}
@@ -1,19 +1,137 @@
 package services
 
-type Error struct {
-	Message string
-}
+import "github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
 
-func NewError(message string) *Error {
-	return &Error{message}
-}
+var (
+	InvalidConfirmationTokenError = apierrors.NewError(
+		apierrors.KindValidation, "invalid_confirmation_token", "invalid token",
+	)
+	InvalidOIDCStateError = apierrors.NewError(
+		apierrors.KindValidation, "invalid_oidc_state", "invalid or expired oidc state",
+	)
+	TokenRateLimitTooHighError = apierrors.NewError(
+		apierrors.KindValidation, "token_rate_limit_too_high", "personal access token rate limit is too high",
+	)
+	WebhookURLNotAllowedError = &apierrors.Error{
+		Kind:    apierrors.KindValidation,
+		Code:    "webhook_url_not_allowed",
+		Message: "webhook url must be http or https url of public host",
+		Fields:  []apierrors.FieldError{{Field: "url", Rule: "public_url"}},
+	}
+	AlertRuleDirectionNotTrackedError = &apierrors.Error{
+		Kind:    apierrors.KindValidation,
+		Code:    "alert_rule_direction_not_tracked",
+		Message: "direction is not tracked by user",
+		Fields:  []apierrors.FieldError{{Field: "direction_id", Rule: "tracked"}},
+	}
+	InvalidCursorError = apierrors.NewError(apierrors.KindValidation, "invalid_cursor", "invalid cursor")
+	SnilsNotSetError   = &apierrors.Error{
+		Kind:    apierrors.KindValidation,
+		Code:    "snils_not_set",
+		Message: "snils isn't set, set it in profile to get ratings",
+		Fields:  []apierrors.FieldError{{Field: "snils", Rule: "required"}},
+	}
+)
+
+var (
+	InvalidUsernameOrPasswordError = apierrors.NewError(
+		apierrors.KindUnauthorized, "invalid_credentials", "invalid username or password",
+	)
+	InvalidTokenError       = apierrors.NewError(apierrors.KindUnauthorized, "invalid_token", "invalid token")
+	RefreshTokenReusedError = apierrors.NewError(
+		apierrors.KindUnauthorized, "refresh_token_reused", "refresh token is already used, session is revoked",
+	)
+	OIDCAuthenticationFailedError = apierrors.NewError(
+		apierrors.KindUnauthorized, "oidc_authentication_failed", "oidc authentication failed",
+	)
+	InvalidTwoFactorChallengeError = apierrors.NewError(
+		apierrors.KindUnauthorized, "invalid_two_factor_challenge", "invalid or expired two-factor challenge",
+	)
+	InvalidSignInTwoFactorCodeError = apierrors.NewError(
+		apierrors.KindUnauthorized, "invalid_sign_in_two_factor_code", "invalid two-factor code",
+	)
+)
 
-func (e *Error) Error() string {
-	return e.Message
-}
+var (
+	InvalidPasswordError       = apierrors.NewError(apierrors.KindForbidden, "invalid_password", "invalid password")
+	OIDCIdentityNotLinkedError = apierrors.NewError(
+		apierrors.KindForbidden, "oidc_identity_not_linked", "identity is not linked",
+	)
+	InvalidTwoFactorCodeError = apierrors.NewError(
+		apierrors.KindForbidden, "invalid_two_factor_code", "invalid two-factor code",
+	)
+)
 
 var (
-	UserAlreadyExistsError         = NewError("user already exists")
-	InvalidUsernameOrPasswordError = NewError("invalid username or password")
-	InvalidTokenError              = NewError("invalid token")
+	UserNotFoundError         = apierrors.NewError(apierrors.KindNotFound, "user_not_found", "user not found")
+	SessionNotFoundError      = apierrors.NewError(apierrors.KindNotFound, "session_not_found", "session not found")
+	OIDCProviderNotFoundError = apierrors.NewError(
+		apierrors.KindNotFound, "oidc_provider_not_found", "oidc provider not found",
+	)
+	OIDCIdentityNotFoundError = apierrors.NewError(
+		apierrors.KindNotFound, "oidc_identity_not_found", "identity not found",
+	)
+	TOTPNotEnrolledError = apierrors.NewError(
+		apierrors.KindNotFound, "totp_not_enrolled", "two-factor authentication isn't enrolled",
+	)
+	PersonalAccessTokenNotFoundError = apierrors.NewError(
+		apierrors.KindNotFound, "personal_access_token_not_found", "personal access token not found",
+	)
+	WebhookNotFoundError   = apierrors.NewError(apierrors.KindNotFound, "webhook_not_found", "webhook not found")
+	TelegramNotLinkedError = apierrors.NewError(
+		apierrors.KindNotFound, "telegram_not_linked", "telegram is not linked",
+	)
+	AlertRuleNotFoundError = apierrors.NewError(
+		apierrors.KindNotFound, "alert_rule_not_found", "alert rule not found",
+	)
+	UniversityNotFoundError = apierrors.NewError(
+		apierrors.KindNotFound, "university_not_found", "university not found",
+	)
+	DirectionNotFoundError   = apierrors.NewError(apierrors.KindNotFound, "direction_not_found", "direction not found")
+	DirectionNotTrackedError = apierrors.NewError(
+		apierrors.KindNotFound, "direction_not_tracked", "direction is not tracked by user",
+	)
+)
+
+var (
+	UserAlreadyExistsError = apierrors.NewError(
+		apierrors.KindConflict, "user_already_exists", "user already exists",
+	)
+	SnilsAlreadyUsedError = apierrors.NewError(
+		apierrors.KindConflict, "snils_already_used", "snils is already used",
+	)
+	OIDCIdentityAlreadyLinkedError = apierrors.NewError(
+		apierrors.KindConflict, "oidc_identity_already_linked", "identity is already linked to user",
+	)
+	TOTPAlreadyEnabledError = apierrors.NewError(
+		apierrors.KindConflict, "totp_already_enabled", "two-factor authentication is already enabled",
+	)
+	TOTPNotEnabledError = apierrors.NewError(
+		apierrors.KindConflict, "totp_not_enabled", "two-factor authentication isn't enabled",
+	)
+	TooManyPersonalAccessTokensError = apierrors.NewError(
+		apierrors.KindConflict, "too_many_personal_access_tokens",
+		"too many personal access tokens, revoke unused ones",
+	)
+	EmailAlreadyUsedError = apierrors.NewError(apierrors.KindConflict, "email_already_used", "email is already used")
+)
+
+var (
+	SignInRateLimitExceededError = apierrors.NewError(
+		apierrors.KindRateLimited, "sign_in_rate_limit_exceeded", "too many sign in attempts, try again later",
+	)
+	SignInLockedError = apierrors.NewError(
+		apierrors.KindLocked, "sign_in_locked", "sign in is locked after too many failed attempts, try again later",
+	)
+	PasswordResetRateLimitExceededError = apierrors.NewError(
+		apierrors.KindRateLimited, "password_reset_rate_limit_exceeded",
+		"too many password reset requests, try again later",
+	)
+	EmailRateLimitExceededError = apierrors.NewError(
+		apierrors.KindRateLimited, "email_rate_limit_exceeded", "too many emails, try again later",
+	)
+)
+
+var RatingListUnavailableError = apierrors.NewError(
+	apierrors.KindUpstreamUnavailable, "rating_list_unavailable", "rating list of university is unavailable",
 )
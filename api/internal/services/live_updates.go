@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type LiveUpdatesImpl struct {
+	liveUpdatesCache cache.LiveUpdates
+	cfg              *config.LiveUpdates
+	logger           *logging.Logger
+}
+
+func NewLiveUpdatesImpl(liveUpdatesCache cache.LiveUpdates) *LiveUpdatesImpl {
+	return &LiveUpdatesImpl{
+		liveUpdatesCache: liveUpdatesCache,
+		cfg:              config.Get().LiveUpdates,
+		logger:           logging.NewLogger("live updates services"),
+	}
+}
+
+// Publish sends user directions with rating to connected clients if they differ
+// from the latest published ones.
+func (s *LiveUpdatesImpl) Publish(
+	ctx context.Context,
+	userID uint,
+	directions []dto.UniversityDirectionsWithRating,
+) error {
+	payload, err := json.Marshal(directions)
+	if err != nil {
+		return fmt.Errorf("error while marshaling live update: %w", err)
+	}
+
+	latest, err := s.liveUpdatesCache.GetLatest(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error while getting latest live update: %w", err)
+	}
+
+	if latest == string(payload) {
+		return nil
+	}
+
+	if err := s.liveUpdatesCache.Publish(ctx, userID, string(payload), s.cfg.LatestTTL); err != nil {
+		return fmt.Errorf("error while publishing live update: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LiveUpdatesImpl) GetLatest(ctx context.Context, userID uint) (string, error) {
+	payload, err := s.liveUpdatesCache.GetLatest(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("error while getting latest live update: %w", err)
+	}
+
+	return payload, nil
+}
+
+func (s *LiveUpdatesImpl) Subscribe(ctx context.Context, userID uint) (<-chan string, error) {
+	payloads, err := s.liveUpdatesCache.Subscribe(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while subscribing to live updates: %w", err)
+	}
+
+	return payloads, nil
+}
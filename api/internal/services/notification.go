@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+type NotificationImpl struct {
+	channels []Notification
+	logger   *logging.Logger
+}
+
+func NewNotificationImpl(channels ...Notification) *NotificationImpl {
+	return &NotificationImpl{
+		channels: channels,
+		logger:   logging.NewLogger("notification services"),
+	}
+}
+
+// Notify passes event to every notification channel. Failed channel doesn't prevent
+// event from being passed to others, the first occurred error is returned.
+func (s *NotificationImpl) Notify(ctx context.Context, event dto.RatingEvent) error {
+	var firstErr error
+
+	for _, channel := range s.channels {
+		if err := channel.Notify(ctx, event); err != nil {
+			s.logger.Error(err)
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("error while notifying about rating event: %w", err)
+			}
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/oidc"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const (
+	oidcStateLength        = 16
+	oidcNonceLength        = 16
+	oidcUsernamePrefix     = "u"
+	oidcUsernameLength     = 4
+	oidcPasswordLength     = 16
+	oidcProviderTimeout    = 10 * time.Second
+	oidcLoginStateUserID   = 0
+	oidcUsernameRetryCount = 3
+)
+
+type OIDCImpl struct {
+	userIdentityRepository repository.UserIdentity
+	oidcStateCache         cache.OIDCState
+	authorizationService   Authorization
+	auditLogService        AuditLog
+	providers              oidc.Providers
+	cfg                    *config.OIDC
+	logger                 *logging.Logger
+}
+
+func NewOIDCImpl(
+	userIdentityRepository repository.UserIdentity,
+	oidcStateCache cache.OIDCState,
+	authorizationService Authorization,
+	auditLogService AuditLog,
+	providers oidc.Providers,
+) *OIDCImpl {
+	return &OIDCImpl{
+		userIdentityRepository: userIdentityRepository,
+		oidcStateCache:         oidcStateCache,
+		authorizationService:   authorizationService,
+		auditLogService:        auditLogService,
+		providers:              providers,
+		cfg:                    config.Get().OIDC,
+		logger:                 logging.NewLogger("oidc services"),
+	}
+}
+
+// Authorize starts authorization with provider. If userID isn't zero, authorized identity
+// is linked to the user, otherwise it is used for signing in.
+func (s *OIDCImpl) Authorize(ctx context.Context, providerName string, userID uint) (*dto.OIDCAuthorizationURL, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, OIDCProviderNotFoundError
+	}
+
+	state, err := random.Hex(oidcStateLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating oidc state: %w", err)
+	}
+
+	nonce, err := random.Hex(oidcNonceLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating oidc nonce: %w", err)
+	}
+
+	codeVerifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		return nil, fmt.Errorf("error while generating pkce code verifier: %w", err)
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, oidcProviderTimeout)
+	defer cancel()
+
+	url, err := provider.AuthCodeURL(providerCtx, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("error while building authorization url: %w", err)
+	}
+
+	if err := s.oidcStateCache.Save(ctx, state, models.OIDCState{
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		UserID:       userID,
+	}, s.cfg.StateTTL); err != nil {
+		return nil, fmt.Errorf("error while saving oidc state in cache: %w", err)
+	}
+
+	return &dto.OIDCAuthorizationURL{URL: url}, nil
+}
+
+// SignIn completes authorization started for signing in and returns tokens of user owning
+// identity or two-factor challenge as sign in by credentials does. Unknown identities get
+// new user if auto provisioning is enabled.
+func (s *OIDCImpl) SignIn(
+	ctx context.Context, providerName string, data dto.OIDCCallback, client dto.ClientInfo,
+) (*dto.SignInResult, error) {
+	identity, err := s.exchange(ctx, providerName, data, oidcLoginStateUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIdentity, err := s.userIdentityRepository.GetByProviderSubject(ctx, providerName, identity.Subject)
+	if err == nil {
+		return s.signIn(ctx, userIdentity.UserID, providerName, client)
+	}
+
+	if !errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, fmt.Errorf("error while getting user identity: %w", err)
+	}
+
+	if !s.cfg.AutoProvision {
+		return nil, OIDCIdentityNotLinkedError
+	}
+
+	userID, err := s.provisionUser(ctx, providerName, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserSignedUp, client, map[string]string{"provider": providerName})
+
+	return s.signIn(ctx, userID, providerName, client)
+}
+
+func (s *OIDCImpl) signIn(
+	ctx context.Context,
+	userID uint,
+	providerName string,
+	client dto.ClientInfo,
+) (*dto.SignInResult, error) {
+	result, err := s.authorizationService.GenerateTokensForUser(ctx, userID, client)
+	if err != nil || result.Challenge != nil {
+		return result, err
+	}
+
+	s.auditLogService.Record(ctx, userID, auditActionUserSignedIn, client, map[string]string{
+		"method":   signInMethodOIDC,
+		"provider": providerName,
+	})
+
+	return result, nil
+}
+
+// Link completes authorization started by signed in user and links identity to the user.
+func (s *OIDCImpl) Link(ctx context.Context, userID uint, providerName string, data dto.OIDCCallback) error {
+	identity, err := s.exchange(ctx, providerName, data, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.userIdentityRepository.Create(ctx, rdto.UserIdentityCreating{
+		UserID:   userID,
+		Provider: providerName,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}); err != nil {
+		s.logger.Error(err)
+
+		return OIDCIdentityAlreadyLinkedError
+	}
+
+	return nil
+}
+
+func (s *OIDCImpl) GetIdentities(ctx context.Context, userID uint) ([]dto.UserIdentity, error) {
+	identities, err := s.userIdentityRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user identities: %w", err)
+	}
+
+	result := make([]dto.UserIdentity, 0, len(identities))
+	for _, identity := range identities {
+		result = append(result, dto.NewUserIdentity(identity))
+	}
+
+	return result, nil
+}
+
+func (s *OIDCImpl) Unlink(ctx context.Context, userID uint, providerName string) error {
+	if err := s.userIdentityRepository.Delete(ctx, userID, providerName); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return OIDCIdentityNotFoundError
+		}
+
+		return fmt.Errorf("error while deleting user identity: %w", err)
+	}
+
+	return nil
+}
+
+// exchange checks that state was issued for the same provider and user and exchanges
+// authorization code for identity. State is deleted, so it can't be replayed.
+func (s *OIDCImpl) exchange(
+	ctx context.Context,
+	providerName string,
+	data dto.OIDCCallback,
+	userID uint,
+) (*oidc.Identity, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, OIDCProviderNotFoundError
+	}
+
+	state, err := s.oidcStateCache.Pop(ctx, data.State)
+	if err != nil {
+		s.logger.Error(err)
+
+		return nil, InvalidOIDCStateError
+	}
+
+	if state.Provider != providerName || state.UserID != userID {
+		return nil, InvalidOIDCStateError
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, oidcProviderTimeout)
+	defer cancel()
+
+	identity, err := provider.Exchange(providerCtx, data.Code, state.Nonce, state.CodeVerifier)
+	if err != nil {
+		s.logger.Error(err)
+
+		return nil, OIDCAuthenticationFailedError
+	}
+
+	return identity, nil
+}
+
+// provisionUser creates user for identity. User gets random username and password,
+// so it can sign in only with identity provider until password is reset.
+func (s *OIDCImpl) provisionUser(ctx context.Context, providerName string, identity *oidc.Identity) (uint, error) {
+	password, err := random.Hex(oidcPasswordLength)
+	if err != nil {
+		return 0, fmt.Errorf("error while generating password: %w", err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, fmt.Errorf("error while crypting password: %w", err)
+	}
+
+	identityCreating := rdto.UserIdentityCreating{
+		Provider: providerName,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}
+
+	for i := 0; i < oidcUsernameRetryCount; i++ {
+		suffix, err := random.Hex(oidcUsernameLength)
+		if err != nil {
+			return 0, fmt.Errorf("error while generating username: %w", err)
+		}
+
+		userID, err := s.userIdentityRepository.CreateWithUser(ctx, rdto.UserCreating{
+			Username:   oidcUsernamePrefix + suffix,
+			Password:   string(hashedPassword),
+			FirstName:  identity.GivenName,
+			MiddleName: identity.MiddleName,
+			LastName:   identity.FamilyName,
+		}, identityCreating)
+		if err == nil {
+			return userID, nil
+		}
+
+		if errors.Is(err, repository.ErrIdentityAlreadyLinked) {
+			return 0, OIDCIdentityAlreadyLinkedError
+		}
+
+		if !errors.Is(err, repository.ErrUserAlreadyExists) {
+			return 0, fmt.Errorf("error while creating user with identity: %w", err)
+		}
+	}
+
+	return 0, UserAlreadyExistsError
+}
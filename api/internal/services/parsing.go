@@ -1,18 +1,23 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
@@ -44,26 +49,30 @@ const (
 	priorityOne            = 1
 )
 
-func (s *ParsingImpl) ParseRating(university string, ratingURL string, userSnils string) (*dto.ParsingResult, error) {
-	ratingList, err := s.cache.Get(ratingURL)
+// ParseRating finds user in rating list, lists are fetched only if they aren't cached.
+// Fetches are logged with url, status and duration and request id of context, fetching
+// and parsing of lists are traced as spans of their own.
+func (s *ParsingImpl) ParseRating(
+	ctx context.Context,
+	university string,
+	ratingURL string,
+	userSnils string,
+) (*dto.ParsingResult, error) {
+	ratingList, err := s.cache.Get(ctx, ratingURL)
 	if err != nil {
-		res, req := fasthttp.AcquireResponse(), fasthttp.AcquireRequest()
-		req.SetRequestURI(ratingURL)
-
-		if err := s.client.Do(req, res); err != nil {
-			return nil, fmt.Errorf("error while getting rating list page: %w", err)
-		}
-
-		if res.StatusCode() != fasthttp.StatusOK {
-			return nil, fmt.Errorf("getting %s by HTML: %v", ratingURL, res.StatusCode())
+		ratingList, err = s.fetchRatingList(ctx, ratingURL)
+		if err != nil {
+			return nil, err
 		}
 
-		ratingList = string(res.Body())
-		if err := s.cache.Save(ratingURL, ratingList, config.Get().Parsing.RatingListTTL); err != nil {
+		if err := s.cache.Save(ctx, ratingURL, ratingList, config.Get().Parsing.RatingListTTL); err != nil {
 			return nil, fmt.Errorf("error while caching rating list: %w", err)
 		}
 	}
 
+	_, span := tracing.Start(ctx, "rating_list.parse", attribute.String("university", university))
+	defer span.End()
+
 	parsedRatingList, err := goquery.NewDocumentFromReader(ioutil.NopCloser(strings.NewReader(ratingList)))
 	if err != nil {
 		return nil, fmt.Errorf("analise by HTML error: %w", err)
@@ -79,6 +88,48 @@ func (s *ParsingImpl) ParseRating(university string, ratingURL string, userSnils
 	}
 }
 
+func (s *ParsingImpl) fetchRatingList(ctx context.Context, ratingURL string) (string, error) {
+	ctx, span := tracing.Start(ctx, "rating_list.fetch",
+		semconv.HTTPMethodKey.String(fasthttp.MethodGet),
+		semconv.HTTPURLKey.String(ratingURL),
+	)
+	defer span.End()
+
+	res, req := fasthttp.AcquireResponse(), fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseResponse(res)
+	defer fasthttp.ReleaseRequest(req)
+
+	req.SetRequestURI(ratingURL)
+
+	start := time.Now()
+	err := s.client.Do(req, res)
+	logger := s.logger.WithContext(ctx).WithFields(logging.Fields{
+		"url":         ratingURL,
+		"status":      res.StatusCode(),
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(res.StatusCode()))
+
+	if err != nil {
+		logger.Error(err)
+		tracing.RecordError(span, err)
+
+		return "", RatingListUnavailableError.Wrap(fmt.Errorf("error while getting rating list page: %w", err))
+	}
+
+	if res.StatusCode() != fasthttp.StatusOK {
+		err := fmt.Errorf("getting %s by HTML: %v", ratingURL, res.StatusCode())
+		logger.Error(err)
+		tracing.RecordError(span, err)
+
+		return "", RatingListUnavailableError.Wrap(err)
+	}
+
+	logger.Infof("rating list is fetched")
+
+	return string(res.Body()), nil
+}
+
 func (s *ParsingImpl) formatSnils(snils string) string {
 	return fmt.Sprintf("%s-%s-%s %s", snils[:3], snils[3:6], snils[6:9], snils[9:11])
 }
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+)
+
+const (
+	passwordResetTokenLength = 32
+
+	// passwordResetIssueTimeout limits issuing of token, which outlives request.
+	passwordResetIssueTimeout = 30 * time.Second
+)
+
+type PasswordResetImpl struct {
+	userRepository       repository.User
+	passwordResetCache   cache.PasswordReset
+	rateLimitCache       cache.RateLimit
+	authorizationService Authorization
+	notifiers            []PasswordResetNotifier
+	cfg                  *config.PasswordReset
+	logger               *logging.Logger
+}
+
+func NewPasswordResetImpl(
+	userRepository repository.User,
+	passwordResetCache cache.PasswordReset,
+	rateLimitCache cache.RateLimit,
+	authorizationService Authorization,
+	notifiers ...PasswordResetNotifier,
+) *PasswordResetImpl {
+	return &PasswordResetImpl{
+		userRepository:       userRepository,
+		passwordResetCache:   passwordResetCache,
+		rateLimitCache:       rateLimitCache,
+		authorizationService: authorizationService,
+		notifiers:            notifiers,
+		cfg:                  config.Get().PasswordReset,
+		logger:               logging.NewLogger("password reset services"),
+	}
+}
+
+// RequestReset sends single-use reset token to the first notifier which can reach user.
+// Token is issued in background and the result doesn't depend on whether user exists,
+// so the response doesn't reveal usernames. Token is issued with context detached from
+// request one, because request is finished before that.
+func (s *PasswordResetImpl) RequestReset(ctx context.Context, data dto.PasswordResetRequesting) error {
+	allowed, err := s.rateLimitCache.Allow(ctx,
+		fmt.Sprintf("password_reset_%s", strings.ToLower(data.Username)), s.cfg.RateLimit, s.cfg.RateLimitWindow,
+	)
+	if err != nil {
+		return fmt.Errorf("error while checking password reset rate limit: %w", err)
+	}
+
+	if !allowed {
+		return PasswordResetRateLimitExceededError
+	}
+
+	issueCtx := logging.ContextWithRequestID(tracing.Detach(ctx), logging.RequestIDFromContext(ctx))
+	issueCtx, cancel := context.WithTimeout(issueCtx, passwordResetIssueTimeout)
+
+	go func() {
+		defer cancel()
+
+		if err := s.issueToken(issueCtx, data.Username); err != nil {
+			s.logger.Error(err)
+		}
+	}()
+
+	return nil
+}
+
+// ConfirmReset sets new password of token user and revokes all user sessions.
+func (s *PasswordResetImpl) ConfirmReset(ctx context.Context, data dto.PasswordResetConfirming) error {
+	userID, err := s.passwordResetCache.Pop(ctx, hashToken(data.Token))
+	if err != nil {
+		s.logger.Error(err)
+
+		return InvalidConfirmationTokenError
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(data.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("error while crypting password: %w", err)
+	}
+
+	if err := s.userRepository.UpdatePassword(ctx, userID, string(hashedPassword)); err != nil {
+		return fmt.Errorf("error while updating user password by repository: %w", err)
+	}
+
+	if err := s.authorizationService.RevokeSessions(ctx, userID); err != nil {
+		return fmt.Errorf("error while revoking user sessions: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PasswordResetImpl) issueToken(ctx context.Context, username string) error {
+	user, err := s.userRepository.GetUserByUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("error while getting user by repository: %w", err)
+	}
+
+	token, err := random.Hex(passwordResetTokenLength)
+	if err != nil {
+		return fmt.Errorf("error while generating password reset token: %w", err)
+	}
+
+	if err := s.passwordResetCache.Save(ctx, hashToken(token), user.ID, s.cfg.TokenTTL); err != nil {
+		return fmt.Errorf("error while saving password reset token in cache: %w", err)
+	}
+
+	resetURL := fmt.Sprintf("%s?token=%s", s.cfg.URL, url.QueryEscape(token))
+
+	for _, notifier := range s.notifiers {
+		sent, err := notifier.SendPasswordReset(ctx, user, resetURL, token)
+		if err != nil {
+			s.logger.Error(err)
+
+			continue
+		}
+
+		if sent {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("password reset token of user %d isn't delivered: no available notifier", user.ID)
+}
+
+// hashToken returns hash of token, which is stored instead of token, so tokens
+// can't be used by the one who reads cache or database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,78 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+)
+
+type passwordResetUserRepository struct {
+	repository.User
+	requestFinished chan struct{}
+}
+
+func (r passwordResetUserRepository) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+	<-r.requestFinished
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &models.User{ID: 1, Username: username}, nil
+}
+
+type passwordResetCache struct {
+	cache.PasswordReset
+	saved chan uint
+}
+
+func (c passwordResetCache) Save(ctx context.Context, _ string, userID uint, _ time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.saved <- userID
+
+	return nil
+}
+
+type unlimitedRateLimitCache struct{}
+
+func (unlimitedRateLimitCache) Allow(context.Context, string, int, time.Duration) (bool, error) {
+	return true, nil
+}
+
+type passwordResetNotifier struct{}
+
+func (passwordResetNotifier) SendPasswordReset(context.Context, *models.User, string, string) (bool, error) {
+	return true, nil
+}
+
+func TestPasswordReset_RequestReset(t *testing.T) {
+	t.Parallel()
+
+	users := passwordResetUserRepository{requestFinished: make(chan struct{})}
+	tokens := passwordResetCache{saved: make(chan uint, 1)}
+	s := services.NewPasswordResetImpl(users, tokens, unlimitedRateLimitCache{}, nil, passwordResetNotifier{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.NoError(t, s.RequestReset(ctx, dto.PasswordResetRequesting{Username: "user"}))
+
+	cancel()
+	close(users.requestFinished)
+
+	select {
+	case userID := <-tokens.saved:
+		assert.Equal(t, uint(1), userID)
+	case <-time.After(time.Second):
+		assert.Fail(t, "password reset token isn't issued after request is finished")
+	}
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const personalAccessTokenLength = 32
+
+type PersonalAccessTokenImpl struct {
+	personalAccessTokenRepository repository.PersonalAccessToken
+	rateLimitCache                cache.RateLimit
+	cfg                           *config.PersonalAccessTokens
+	logger                        *logging.Logger
+}
+
+func NewPersonalAccessTokenImpl(
+	personalAccessTokenRepository repository.PersonalAccessToken,
+	rateLimitCache cache.RateLimit,
+) *PersonalAccessTokenImpl {
+	return &PersonalAccessTokenImpl{
+		personalAccessTokenRepository: personalAccessTokenRepository,
+		rateLimitCache:                rateLimitCache,
+		cfg:                           config.Get().PAT,
+		logger:                        logging.NewLogger("personal access token services"),
+	}
+}
+
+// Create creates personal access token of user. Token value is returned only once,
+// only its hash is stored.
+func (s *PersonalAccessTokenImpl) Create(
+	ctx context.Context, userID uint, data dto.PersonalAccessTokenCreating,
+) (*dto.CreatedPersonalAccessToken, error) {
+	rateLimit := s.cfg.DefaultRateLimit
+	if data.RateLimit != nil {
+		rateLimit = *data.RateLimit
+	}
+
+	if rateLimit > s.cfg.MaxRateLimit {
+		return nil, TokenRateLimitTooHighError
+	}
+
+	tokens, err := s.personalAccessTokenRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user personal access tokens by repository: %w", err)
+	}
+
+	if len(tokens) >= s.cfg.MaxPerUser {
+		return nil, TooManyPersonalAccessTokensError
+	}
+
+	suffix, err := random.Hex(personalAccessTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating personal access token: %w", err)
+	}
+
+	token := authorization.PersonalAccessTokenPrefix + suffix
+
+	var expiresAt sql.NullTime
+	if data.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: *data.ExpiresAt, Valid: true}
+	}
+
+	id, err := s.personalAccessTokenRepository.Create(ctx, rdto.PersonalAccessTokenCreating{
+		UserID:    userID,
+		Name:      data.Name,
+		TokenHash: hashToken(token),
+		Scopes:    strings.Join(data.Scopes, " "),
+		RateLimit: rateLimit,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while creating personal access token by repository: %w", err)
+	}
+
+	created, err := s.personalAccessTokenRepository.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting personal access token by repository: %w", err)
+	}
+
+	return &dto.CreatedPersonalAccessToken{
+		PersonalAccessToken: dto.NewPersonalAccessToken(*created),
+		Token:               token,
+	}, nil
+}
+
+func (s *PersonalAccessTokenImpl) GetForUser(ctx context.Context, userID uint) ([]dto.PersonalAccessToken, error) {
+	tokens, err := s.personalAccessTokenRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user personal access tokens by repository: %w", err)
+	}
+
+	result := make([]dto.PersonalAccessToken, 0, len(tokens))
+	for _, t := range tokens {
+		result = append(result, dto.NewPersonalAccessToken(t))
+	}
+
+	return result, nil
+}
+
+func (s *PersonalAccessTokenImpl) Revoke(ctx context.Context, userID uint, id uint) error {
+	if err := s.personalAccessTokenRepository.Delete(ctx, userID, id); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return PersonalAccessTokenNotFoundError
+		}
+
+		return fmt.Errorf("error while deleting personal access token by repository: %w", err)
+	}
+
+	return nil
+}
+
+// AuthenticatePersonalAccessToken returns user and scopes of token. Requests of token are
+// counted against its rate limit, last usage time is updated at most once per interval.
+func (s *PersonalAccessTokenImpl) AuthenticatePersonalAccessToken(
+	ctx context.Context, token string,
+) (*authorization.PersonalAccessTokenClaims, error) {
+	t, err := s.personalAccessTokenRepository.GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, InvalidTokenError
+		}
+
+		return nil, fmt.Errorf("error while getting personal access token by repository: %w", err)
+	}
+
+	now := time.Now()
+	if t.ExpiresAt.Valid && t.ExpiresAt.Time.Before(now) {
+		return nil, InvalidTokenError
+	}
+
+	allowed, err := s.rateLimitCache.Allow(ctx,
+		fmt.Sprintf("personal_access_token_%d", t.ID), t.RateLimit, s.cfg.RateLimitWindow,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while checking personal access token rate limit: %w", err)
+	}
+
+	if !allowed {
+		return nil, authorization.ErrPersonalAccessTokenRateLimitExceeded
+	}
+
+	if !t.LastUsedAt.Valid || now.Sub(t.LastUsedAt.Time) >= s.cfg.LastUsedInterval {
+		if err := s.personalAccessTokenRepository.UpdateLastUsed(ctx, t.ID, now); err != nil {
+			s.logger.Error(err)
+		}
+	}
+
+	return &authorization.PersonalAccessTokenClaims{
+		TokenID: t.ID,
+		UserID:  t.UserID,
+		Role:    t.Role,
+		Scopes:  strings.Fields(t.Scopes),
+	}, nil
+}
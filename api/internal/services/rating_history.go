@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const eventIDLength = 16
+
+type RatingHistoryImpl struct {
+	ratingHistoryRepository repository.RatingHistory
+	alertRuleService        AlertRule
+	notificationService     Notification
+	logger                  *logging.Logger
+}
+
+func NewRatingHistoryImpl(
+	ratingHistoryRepository repository.RatingHistory,
+	alertRuleService AlertRule,
+	notificationService Notification,
+) *RatingHistoryImpl {
+	return &RatingHistoryImpl{
+		ratingHistoryRepository: ratingHistoryRepository,
+		alertRuleService:        alertRuleService,
+		notificationService:     notificationService,
+		logger:                  logging.NewLogger("rating history services"),
+	}
+}
+
+// Track saves parsing results which differ from the latest saved ones and notifies
+// about changes of position, submitted consents above the user and budget status
+// and about triggered user alert rules. Webhook deliveries of events are saved with
+// results, other notification channels are notified after that.
+func (s *RatingHistoryImpl) Track(ctx context.Context, userID uint, directions []dto.DirectionWithParsingResult) error {
+	var firstErr error
+
+	for _, d := range directions {
+		if d.ParsingResult == dto.EmptyParsingResult {
+			continue
+		}
+
+		if err := s.trackDirection(ctx, userID, d); err != nil {
+			s.logger.Error(err)
+
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+func (s *RatingHistoryImpl) GetForDirection(
+	ctx context.Context, userID uint,
+	directionID uint,
+	limit int,
+) ([]dto.RatingHistoryRecord, error) {
+	records, err := s.ratingHistoryRepository.GetForDirection(ctx, userID, directionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rating history by repository: %w", err)
+	}
+
+	history := make([]dto.RatingHistoryRecord, len(records))
+	for i, r := range records {
+		history[i] = dto.NewRatingHistoryRecord(r)
+	}
+
+	return history, nil
+}
+
+// GetForDirections returns the latest records of directions by direction ids.
+func (s *RatingHistoryImpl) GetForDirections(
+	ctx context.Context, userID uint,
+	directionIDs []uint,
+	limit int,
+) (map[uint][]dto.RatingHistoryRecord, error) {
+	records, err := s.ratingHistoryRepository.GetForDirections(ctx, userID, directionIDs, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting rating history of directions by repository: %w", err)
+	}
+
+	history := make(map[uint][]dto.RatingHistoryRecord, len(directionIDs))
+	for _, r := range records {
+		history[r.DirectionID] = append(history[r.DirectionID], dto.NewRatingHistoryRecord(r))
+	}
+
+	return history, nil
+}
+
+func (s *RatingHistoryImpl) trackDirection(ctx context.Context, userID uint, d dto.DirectionWithParsingResult) error {
+	latest, err := s.ratingHistoryRepository.GetLatest(ctx, userID, d.Direction.DirectionID)
+	if err != nil && !errors.Is(err, repository.ErrRecordNotFound) {
+		return fmt.Errorf("error while getting latest rating by repository: %w", err)
+	}
+
+	if latest != nil && mapRatingHistoryToParsingResult(*latest) == d.ParsingResult {
+		return nil
+	}
+
+	var events []dto.RatingEvent
+
+	if latest != nil {
+		events, err = s.detectEvents(ctx, userID, d, mapRatingHistoryToParsingResult(*latest))
+		if err != nil {
+			return err
+		}
+	}
+
+	webhookEvents := make([]rdto.WebhookEvent, 0, len(events))
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("error while marshaling event: %w", err)
+		}
+
+		webhookEvents = append(webhookEvents, rdto.WebhookEvent{ID: event.ID, Type: event.Type, Payload: string(payload)})
+	}
+
+	if err := s.ratingHistoryRepository.Create(
+		ctx, userID, d.Direction.DirectionID, d.ParsingResult, webhookEvents,
+	); err != nil {
+		return fmt.Errorf("error while saving rating by repository: %w", err)
+	}
+
+	var firstErr error
+
+	for _, event := range events {
+		if err := s.notificationService.Notify(ctx, event); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("error while notifying about rating changes: %w", err)
+		}
+	}
+
+	return firstErr
+}
+
+// detectEvents returns events about changes of rating since previous result and about
+// alert rules triggered by them.
+func (s *RatingHistoryImpl) detectEvents(
+	ctx context.Context,
+	userID uint,
+	d dto.DirectionWithParsingResult,
+	previous dto.ParsingResult,
+) ([]dto.RatingEvent, error) {
+	events := make([]dto.RatingEvent, 0)
+	changes := detectRatingChanges(previous, d.ParsingResult)
+
+	if len(changes) != 0 {
+		event, err := newRatingEvent(dto.RatingChangedEvent, userID, d, previous, changes)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+	}
+
+	alerts, err := s.alertRuleService.Evaluate(ctx, userID, d.Direction.DirectionID, previous, d.ParsingResult)
+	if err != nil {
+		return nil, fmt.Errorf("error while evaluating alert rules: %w", err)
+	}
+
+	if len(alerts) != 0 {
+		event, err := newRatingEvent(dto.AlertTriggeredEvent, userID, d, previous, changes)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Alerts = alerts
+		events = append(events, *event)
+	}
+
+	return events, nil
+}
+
+func mapRatingHistoryToParsingResult(r models.RatingHistory) dto.ParsingResult {
+	return dto.ParsingResult{
+		Position:              r.Position,
+		Score:                 r.Score,
+		PriorityOneUpper:      r.PriorityOneUpper,
+		SubmittedConsentUpper: r.SubmittedConsentUpper,
+		BudgetPlaces:          r.BudgetPlaces,
+	}
+}
+
+func detectRatingChanges(previous dto.ParsingResult, current dto.ParsingResult) []string {
+	changes := make([]string, 0)
+
+	if previous.Position != current.Position {
+		changes = append(changes, dto.PositionChange)
+	}
+
+	if previous.SubmittedConsentUpper != current.SubmittedConsentUpper {
+		changes = append(changes, dto.SubmittedConsentUpperChange)
+	}
+
+	if previous.IsWithinBudget() != current.IsWithinBudget() {
+		changes = append(changes, dto.BudgetStatusChange)
+	}
+
+	return changes
+}
+
+func newRatingEvent(
+	eventType string,
+	userID uint,
+	d dto.DirectionWithParsingResult,
+	previous dto.ParsingResult,
+	changes []string,
+) (*dto.RatingEvent, error) {
+	eventID, err := random.Hex(eventIDLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating event id: %w", err)
+	}
+
+	return &dto.RatingEvent{
+		ID:         eventID,
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		UserID:     userID,
+		Direction: dto.RatingEventDirection{
+			ID:             d.Direction.DirectionID,
+			Name:           d.Direction.DirectionName,
+			UniversityID:   d.Direction.UniversityID,
+			UniversityName: d.Direction.UniversityName,
+		},
+		Changes:  changes,
+		Previous: dto.NewRatingState(previous),
+		Current:  dto.NewRatingState(d.ParsingResult),
+	}, nil
+}
@@ -1,64 +1,293 @@
 package services
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/mailer"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/oidc"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/telegram"
 )
 
 type Authorization interface {
-	SignUpUser(userData dto.SigningUp) (uint, error)
-	GenerateTokens(userCredentials dto.UserCredentials) (*dto.AuthorizationTokens, error)
-	RefreshTokens(refreshToken string) (*dto.AuthorizationTokens, error)
-	LogoutUser(userID uint, accessToken string) error
-	IsUserLogout(userID uint) bool
+	SignUpUser(ctx context.Context, userData dto.SigningUp, client dto.ClientInfo) (uint, error)
+	GenerateTokens(
+		ctx context.Context, userCredentials dto.UserCredentials, client dto.ClientInfo,
+	) (*dto.SignInResult, error)
+	SignInTwoFactor(
+		ctx context.Context, data dto.TwoFactorSigningIn, client dto.ClientInfo,
+	) (*dto.AuthorizationTokens, error)
+	GenerateTokensForUser(ctx context.Context, userID uint, client dto.ClientInfo) (*dto.SignInResult, error)
+	RefreshTokens(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.AuthorizationTokens, error)
+	LogoutUser(ctx context.Context, userID uint, accessToken string, client dto.ClientInfo) error
+	GetSessions(ctx context.Context, userID uint, currentSessionID string) ([]dto.Session, error)
+	RevokeSession(ctx context.Context, userID uint, sessionID string) error
+	RevokeSessions(ctx context.Context, userID uint) error
+	ChangePassword(ctx context.Context, userID uint, data dto.PasswordChanging, client dto.ClientInfo) error
+	ParseAccessToken(accessToken string) (*authorization.TokenClaims, error)
+	GetJWKS() authorization.JWKS
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+type PasswordReset interface {
+	RequestReset(ctx context.Context, data dto.PasswordResetRequesting) error
+	ConfirmReset(ctx context.Context, data dto.PasswordResetConfirming) error
+}
+
+// PasswordResetNotifier delivers password reset link to user. It reports false when
+// user can't be reached by it.
+type PasswordResetNotifier interface {
+	SendPasswordReset(ctx context.Context, user *models.User, resetURL string, token string) (bool, error)
+}
+
+type OIDC interface {
+	Authorize(ctx context.Context, providerName string, userID uint) (*dto.OIDCAuthorizationURL, error)
+	SignIn(
+		ctx context.Context, providerName string, data dto.OIDCCallback, client dto.ClientInfo,
+	) (*dto.SignInResult, error)
+	Link(ctx context.Context, userID uint, providerName string, data dto.OIDCCallback) error
+	GetIdentities(ctx context.Context, userID uint) ([]dto.UserIdentity, error)
+	Unlink(ctx context.Context, userID uint, providerName string) error
+}
+
+type TwoFactor interface {
+	GetStatus(ctx context.Context, userID uint) (*dto.TwoFactorStatus, error)
+	Enroll(ctx context.Context, userID uint) (*dto.TOTPEnrollment, error)
+	Confirm(ctx context.Context, userID uint, data dto.TwoFactorCode) (*dto.BackupCodes, error)
+	Disable(ctx context.Context, userID uint, data dto.TwoFactorCode) error
+	RegenerateBackupCodes(ctx context.Context, userID uint, data dto.TwoFactorCode) (*dto.BackupCodes, error)
+	IsEnabled(ctx context.Context, userID uint) (bool, error)
+	VerifyCode(ctx context.Context, userID uint, code string) error
+}
+
+type PersonalAccessToken interface {
+	Create(ctx context.Context, userID uint, data dto.PersonalAccessTokenCreating) (*dto.CreatedPersonalAccessToken, error)
+	GetForUser(ctx context.Context, userID uint) ([]dto.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userID uint, id uint) error
+	AuthenticatePersonalAccessToken(ctx context.Context, token string) (*authorization.PersonalAccessTokenClaims, error)
 }
 
 type User interface {
-	GetUsername(id uint) (*dto.Username, error)
-	GetProfile(id uint) (*dto.UserProfile, error)
+	GetUsername(ctx context.Context, id uint) (*dto.Username, error)
+	GetProfile(ctx context.Context, id uint) (*dto.UserProfile, error)
+	PatchProfile(ctx context.Context, id uint, data dto.UserPatching, client dto.ClientInfo) error
+}
+
+type AuditLog interface {
+	Record(ctx context.Context, userID uint, action string, client dto.ClientInfo, metadata map[string]string)
+	GetForUser(ctx context.Context, userID uint) ([]dto.AuditLogRecord, error)
+	Find(ctx context.Context, query dto.AuditLogQuery) ([]dto.AuditLogRecord, error)
+}
+
+type Account interface {
+	Export(ctx context.Context, userID uint, client dto.ClientInfo) (*dto.UserExport, error)
+	Delete(ctx context.Context, userID uint, client dto.ClientInfo) error
+}
+
+type Admin interface {
+	SetRole(ctx context.Context, adminID uint, userID uint, data dto.RoleSetting, client dto.ClientInfo) error
+	PatchUniversity(ctx context.Context, adminID uint, id uint, data dto.UniversityPatching, client dto.ClientInfo) error
+	PatchDirection(ctx context.Context, adminID uint, id uint, data dto.DirectionPatching, client dto.ClientInfo) error
+	PurgeRatingLists(ctx context.Context, adminID uint, client dto.ClientInfo) (*dto.CachePurged, error)
 }
 
 type Parsing interface {
-	ParseRating(universityName string, ratingURL string, userSnils string) (*dto.ParsingResult, error)
+	ParseRating(
+		ctx context.Context, universityName string, ratingURL string, userSnils string,
+	) (*dto.ParsingResult, error)
 }
 
 type University interface {
-	GetAll() ([]dto.University, error)
-	GetByID(id uint) (*models.University, error)
-	GetForUser(userID uint) ([]dto.University, error)
-	SetForUser(userID uint, universityIDs dto.IDs) error
+	GetAll(ctx context.Context) ([]dto.University, error)
+	GetByID(ctx context.Context, id uint) (*models.University, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]dto.University, error)
+	GetForUser(ctx context.Context, userID uint) ([]dto.University, error)
+	SetForUser(ctx context.Context, userID uint, universityIDs dto.IDs) error
 }
 
 type Direction interface {
-	GetAll() ([]dto.UniversityDirections, error)
-	GetByID(id uint) (*models.Direction, error)
-	GetForUser(userID uint) ([]dto.UniversityDirections, error)
-	GetForUserWithRating(userID uint) ([]dto.UniversityDirectionsWithRating, error)
-	SetForUser(userID uint, directionIDs dto.IDs) error
+	GetAll(ctx context.Context, query dto.DirectionQuery) ([]dto.UniversityDirections, string, error)
+	Find(ctx context.Context, query dto.DirectionQuery) (*dto.DirectionsPage, error)
+	GetByID(ctx context.Context, id uint) (*models.Direction, error)
+	GetForUser(ctx context.Context, userID uint) ([]dto.UniversityDirections, error)
+	GetForUserWithRating(ctx context.Context, userID uint) ([]dto.UniversityDirectionsWithRating, error)
+	SetForUser(ctx context.Context, userID uint, directionIDs dto.IDs, client dto.ClientInfo) error
+	GetTrackedForUser(ctx context.Context, userID uint) ([]dto.UniversityDirection, error)
+	GetTrackedForUserByID(ctx context.Context, userID uint, directionID uint) (*dto.UniversityDirection, error)
+	GetForUniversity(ctx context.Context, universityID uint) ([]dto.UniversityDirection, error)
+	GetForUniversities(ctx context.Context, universityIDs []uint) ([]dto.UniversityDirection, error)
+	AddForUser(ctx context.Context, userID uint, directionID uint, client dto.ClientInfo) (bool, error)
+	RemoveForUser(ctx context.Context, userID uint, directionID uint, client dto.ClientInfo) error
+	RefreshRatings(ctx context.Context) error
+}
+
+type LiveUpdates interface {
+	Publish(ctx context.Context, userID uint, directions []dto.UniversityDirectionsWithRating) error
+	GetLatest(ctx context.Context, userID uint) (string, error)
+	Subscribe(ctx context.Context, userID uint) (<-chan string, error)
+}
+
+type RatingHistory interface {
+	Track(ctx context.Context, userID uint, directions []dto.DirectionWithParsingResult) error
+	GetForDirection(ctx context.Context, userID uint, directionID uint, limit int) ([]dto.RatingHistoryRecord, error)
+	GetForDirections(
+		ctx context.Context, userID uint, directionIDs []uint, limit int,
+	) (map[uint][]dto.RatingHistoryRecord, error)
+}
+
+type AlertRule interface {
+	Create(ctx context.Context, userID uint, data dto.AlertRuleCreating) (*dto.AlertRule, error)
+	Get(ctx context.Context, userID uint, id uint) (*dto.AlertRule, error)
+	GetForUser(ctx context.Context, userID uint) ([]dto.AlertRule, error)
+	Update(ctx context.Context, userID uint, id uint, data dto.AlertRuleUpdating) (*dto.AlertRule, error)
+	Delete(ctx context.Context, userID uint, id uint) error
+	Evaluate(
+		ctx context.Context, userID uint, directionID uint, previous dto.ParsingResult, current dto.ParsingResult,
+	) ([]dto.TriggeredAlert, error)
+}
+
+type Notification interface {
+	Notify(ctx context.Context, event dto.RatingEvent) error
+}
+
+type Webhook interface {
+	Create(ctx context.Context, userID uint, data dto.WebhookCreating) (*dto.WebhookCreated, error)
+	GetForUser(ctx context.Context, userID uint) ([]dto.Webhook, error)
+	Delete(ctx context.Context, userID uint, id uint) error
+	GetDeliveries(ctx context.Context, userID uint, webhookID uint) ([]dto.WebhookDelivery, error)
+	DeliverPending(ctx context.Context) (int, error)
+}
+
+type Email interface {
+	RequestVerification(ctx context.Context, userID uint, data dto.EmailAddress) error
+	Verify(ctx context.Context, token string) error
+	PatchSettings(ctx context.Context, userID uint, data dto.EmailSettings) error
+	Unsubscribe(ctx context.Context, token string) error
+	Notify(ctx context.Context, event dto.RatingEvent) error
+}
+
+type Telegram interface {
+	CreateLinkCode(ctx context.Context, userID uint) (*dto.TelegramLinkCode, error)
+	Link(ctx context.Context, code string, chatID int64) error
+	Unlink(ctx context.Context, userID uint) error
+	GetUserIDByChatID(ctx context.Context, chatID int64) (uint, error)
+	Notify(ctx context.Context, event dto.RatingEvent) error
 }
 
 type Service struct {
 	Authorization
+	PasswordReset
+	OIDC
+	TwoFactor
+	PersonalAccessToken
 	User
+	Account
+	AuditLog
+	Admin
 	Parsing
 	University
 	Direction
+	LiveUpdates
+	RatingHistory
+	AlertRule
+	Notification
+	Webhook
+	Email
+	Telegram
 }
 
-func New(repository *repository.Repository, cache *cache.Cache) *Service {
-	authorizationService := NewAuthorizationImpl(repository.User, cache.RefreshToken, cache.Blacklist)
-	userService := NewUserImpl(repository.User)
-	parsingService := NewParsingImpl(cache.RatingList)
-	universityService := NewUniversityImpl(repository.University)
-	directionService := NewDirectionImpl(repository.Direction, repository.User, universityService, parsingService)
+// New creates services. Every service is wrapped by traced decorator before it is passed to
+// others, so calls of services are spans which are children of spans of their callers.
+func New(
+	repository *repository.Repository,
+	cache *cache.Cache,
+	mailer *mailer.Mailer,
+	emailTemplates *mailer.Templates,
+	telegramClient *telegram.Client,
+	tokens *authorization.JWT,
+	oidcProviders oidc.Providers,
+	snils *encryption.Snils,
+	totpSecrets *encryption.TOTPSecrets,
+) (*Service, error) {
+	auditLogService := tracedAuditLog{NewAuditLogImpl(repository.AuditLog)}
+	twoFactorService := tracedTwoFactor{
+		NewTwoFactorImpl(repository.TwoFactor, repository.User, cache.TwoFactor, totpSecrets),
+	}
+	authorizationService := tracedAuthorization{NewAuthorizationImpl(
+		repository.User, cache.Session, cache.Blacklist, cache.SignInAttempts, cache.TwoFactor, twoFactorService,
+		auditLogService, tokens, snils,
+	)}
+	userService := tracedUser{
+		NewUserImpl(repository.User, repository.RatingHistory, cache.LiveUpdates, auditLogService, snils),
+	}
+	parsingService := tracedParsing{NewParsingImpl(cache.RatingList)}
+	universityService := tracedUniversity{NewUniversityImpl(repository.University)}
+	webhookService := tracedWebhook{NewWebhookImpl(repository.Webhook, repository.WebhookDelivery)}
+	emailImpl, err := NewEmailImpl(
+		repository.User, cache.EmailVerification, cache.RateLimit, mailer, emailTemplates,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating email service: %w", err)
+	}
 
-	return &Service{
-		Authorization: authorizationService,
-		User:          userService,
-		Parsing:       parsingService,
-		University:    universityService,
-		Direction:     directionService,
+	emailService := tracedEmail{emailImpl}
+	telegramImpl := NewTelegramImpl(repository.User, cache.TelegramLinkCode, telegramClient)
+	telegramService := tracedTelegram{telegramImpl}
+	notificationService := tracedNotification{NewNotificationImpl(emailService, telegramService)}
+	passwordResetService := tracedPasswordReset{NewPasswordResetImpl(
+		repository.User, cache.PasswordReset, cache.RateLimit, authorizationService,
+		tracedPasswordResetNotifier{emailImpl, "Email"}, tracedPasswordResetNotifier{telegramImpl, "Telegram"},
+	)}
+	adminService := tracedAdmin{NewAdminImpl(
+		repository.User, repository.University, repository.Direction, cache.RatingList, authorizationService,
+		auditLogService,
+	)}
+	oidcService := tracedOIDC{NewOIDCImpl(
+		repository.UserIdentity, cache.OIDCState, authorizationService, auditLogService, oidcProviders,
+	)}
+	personalAccessTokenService := tracedPersonalAccessToken{
+		NewPersonalAccessTokenImpl(repository.PersonalAccessToken, cache.RateLimit),
+	}
+	alertRuleService := tracedAlertRule{NewAlertRuleImpl(repository.AlertRule, repository.Direction)}
+	ratingHistoryService := tracedRatingHistory{
+		NewRatingHistoryImpl(repository.RatingHistory, alertRuleService, notificationService),
 	}
+	liveUpdatesService := tracedLiveUpdates{NewLiveUpdatesImpl(cache.LiveUpdates)}
+	directionService := tracedDirection{NewDirectionImpl(
+		repository.Direction, repository.User, universityService, parsingService, ratingHistoryService,
+		liveUpdatesService, auditLogService, snils,
+	)}
+	accountService := tracedAccount{NewAccountImpl(
+		repository.User, repository.RatingHistory, cache.PasswordReset, cache.LiveUpdates, cache.EmailVerification,
+		cache.TelegramLinkCode, cache.SignInAttempts, cache.TwoFactor, authorizationService, userService,
+		universityService, directionService, alertRuleService, webhookService, oidcService, auditLogService, snils,
+	)}
+
+	return &Service{
+		Authorization:       authorizationService,
+		PasswordReset:       passwordResetService,
+		OIDC:                oidcService,
+		TwoFactor:           twoFactorService,
+		PersonalAccessToken: personalAccessTokenService,
+		User:                userService,
+		Account:             accountService,
+		AuditLog:            auditLogService,
+		Admin:               adminService,
+		Parsing:             parsingService,
+		University:          universityService,
+		Direction:           directionService,
+		LiveUpdates:         liveUpdatesService,
+		RatingHistory:       ratingHistoryService,
+		AlertRule:           alertRuleService,
+		Notification:        notificationService,
+		Webhook:             webhookService,
+		Email:               emailService,
+		Telegram:            telegramService,
+	}, nil
 }
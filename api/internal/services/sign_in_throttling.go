@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+)
+
+// Reasons of blocked sign in attempts.
+const (
+	signInBlockedByIP      = "ip"
+	signInBlockedByDelay   = "delay"
+	signInBlockedByLockout = "lockout"
+)
+
+var (
+	signInBlockedAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rlmp_sign_in_blocked_attempts_total",
+		Help: "The total number of sign in attempts blocked by throttling",
+	}, []string{"reason"})
+	signInLockouts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rlmp_sign_in_lockouts_total",
+		Help: "The total number of usernames locked after failed sign in attempts",
+	})
+)
+
+// checkSignInThrottling checks that sign in attempt isn't blocked by ip limit, delay or lockout
+// of username. Checks are done before password comparing, so blocked attempts don't cost bcrypt.
+func (s *AuthorizationImpl) checkSignInThrottling(ctx context.Context, username string, ip string) error {
+	if ip != "" {
+		retryAfter, err := s.signInAttemptsCache.HitIP(ctx, ip, s.signInConfig.IPLimit, s.signInConfig.IPWindow)
+		if err != nil {
+			return err
+		}
+
+		if retryAfter > 0 {
+			signInBlockedAttempts.WithLabelValues(signInBlockedByIP).Inc()
+
+			return apierrors.NewRetryError(SignInRateLimitExceededError, retryAfter)
+		}
+	}
+
+	lock, err := s.signInAttemptsCache.GetLock(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if lock > 0 {
+		signInBlockedAttempts.WithLabelValues(signInBlockedByLockout).Inc()
+
+		return apierrors.NewRetryError(SignInLockedError, lock)
+	}
+
+	delay, err := s.signInAttemptsCache.GetDelay(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if delay > 0 {
+		signInBlockedAttempts.WithLabelValues(signInBlockedByDelay).Inc()
+
+		return apierrors.NewRetryError(SignInRateLimitExceededError, delay)
+	}
+
+	return nil
+}
+
+// registerSignInFailure counts failed attempt of username and delays or locks the next attempts.
+func (s *AuthorizationImpl) registerSignInFailure(ctx context.Context, username string) error {
+	failures, err := s.signInAttemptsCache.AddFailure(ctx, username, s.signInConfig.FailureWindow)
+	if err != nil {
+		return err
+	}
+
+	if failures >= int64(s.signInConfig.LockoutThreshold) {
+		if err := s.signInAttemptsCache.Lock(ctx, username, s.signInConfig.LockoutDuration); err != nil {
+			return err
+		}
+
+		signInLockouts.Inc()
+
+		return nil
+	}
+
+	if failures < int64(s.signInConfig.DelayAfter) {
+		return nil
+	}
+
+	return s.signInAttemptsCache.Delay(ctx, username, s.signInDelay(failures))
+}
+
+// signInDelay doubles initial delay for every failure after DelayAfter ones.
+func (s *AuthorizationImpl) signInDelay(failures int64) time.Duration {
+	delay := s.signInConfig.InitialDelay
+
+	for i := int64(s.signInConfig.DelayAfter); i < failures && delay < s.signInConfig.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > s.signInConfig.MaxDelay {
+		return s.signInConfig.MaxDelay
+	}
+
+	return delay
+}
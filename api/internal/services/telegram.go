@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/telegram"
+)
+
+const telegramLinkCodeLength = 4
+
+var (
+	alertMetricNames = map[string]string{
+		dto.PositionMetric:              "позиция",
+		dto.PositionChangeMetric:        "изменение позиции",
+		dto.SubmittedConsentUpperMetric: "согласий выше",
+		dto.PriorityOneUpperMetric:      "первых приоритетов выше",
+		dto.WithinBudgetMetric:          "прохождение на бюджет",
+	}
+	alertOperatorSigns = map[string]string{
+		dto.GreaterOperator:        ">",
+		dto.GreaterOrEqualOperator: "≥",
+		dto.LessOperator:           "<",
+		dto.LessOrEqualOperator:    "≤",
+		dto.EqualOperator:          "=",
+		dto.NotEqualOperator:       "≠",
+	}
+)
+
+type TelegramImpl struct {
+	userRepository        repository.User
+	telegramLinkCodeCache cache.TelegramLinkCode
+	client                *telegram.Client
+	cfg                   *config.Telegram
+	logger                *logging.Logger
+}
+
+func NewTelegramImpl(
+	userRepository repository.User,
+	telegramLinkCodeCache cache.TelegramLinkCode,
+	client *telegram.Client,
+) *TelegramImpl {
+	return &TelegramImpl{
+		userRepository:        userRepository,
+		telegramLinkCodeCache: telegramLinkCodeCache,
+		client:                client,
+		cfg:                   config.Get().Telegram,
+		logger:                logging.NewLogger("telegram services"),
+	}
+}
+
+// CreateLinkCode generates one-time code which links Telegram chat to user
+// after being sent to the bot.
+func (s *TelegramImpl) CreateLinkCode(ctx context.Context, userID uint) (*dto.TelegramLinkCode, error) {
+	code, err := random.Hex(telegramLinkCodeLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating telegram link code: %w", err)
+	}
+
+	code = strings.ToUpper(code)
+
+	if err := s.telegramLinkCodeCache.Save(ctx, code, userID, s.cfg.LinkCodeTTL); err != nil {
+		return nil, fmt.Errorf("error while saving telegram link code in cache: %w", err)
+	}
+
+	linkCode := &dto.TelegramLinkCode{
+		Code:      code,
+		ExpiresIn: int(s.cfg.LinkCodeTTL.Seconds()),
+	}
+
+	if s.cfg.BotUsername != "" {
+		linkCode.URL = fmt.Sprintf("https://t.me/%s?start=%s", s.cfg.BotUsername, code)
+	}
+
+	return linkCode, nil
+}
+
+func (s *TelegramImpl) Link(ctx context.Context, code string, chatID int64) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	userID, err := s.telegramLinkCodeCache.Get(ctx, code)
+	if err != nil {
+		s.logger.Error(err)
+
+		return InvalidConfirmationTokenError
+	}
+
+	if err := s.userRepository.SetTelegramChatID(ctx, userID, chatID); err != nil {
+		return fmt.Errorf("error while setting user telegram chat by repository: %w", err)
+	}
+
+	if err := s.telegramLinkCodeCache.Delete(ctx, code); err != nil {
+		return fmt.Errorf("error while deleting telegram link code from cache: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TelegramImpl) Unlink(ctx context.Context, userID uint) error {
+	if err := s.userRepository.ClearTelegramChatID(ctx, userID); err != nil {
+		return fmt.Errorf("error while clearing user telegram chat by repository: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TelegramImpl) GetUserIDByChatID(ctx context.Context, chatID int64) (uint, error) {
+	user, err := s.userRepository.GetUserByTelegramChatID(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return 0, TelegramNotLinkedError
+		}
+
+		return 0, fmt.Errorf("error while getting user by telegram chat by repository: %w", err)
+	}
+
+	return user.ID, nil
+}
+
+// Notify sends rating changes to linked Telegram chat. It does nothing
+// when bot is disabled.
+func (s *TelegramImpl) Notify(ctx context.Context, event dto.RatingEvent) error {
+	if !s.client.IsEnabled() {
+		return nil
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("error while getting user by repository: %w", err)
+	}
+
+	if !user.TelegramChatID.Valid {
+		return nil
+	}
+
+	if err := s.client.SendMessage(ctx, user.TelegramChatID.Int64, formatRatingEventMessage(event)); err != nil {
+		return fmt.Errorf("error while sending telegram alert: %w", err)
+	}
+
+	return nil
+}
+
+// SendPasswordReset sends password reset link to linked Telegram chat. It reports false
+// when bot is disabled or chat isn't linked.
+func (s *TelegramImpl) SendPasswordReset(
+	ctx context.Context,
+	user *models.User,
+	resetURL string,
+	token string,
+) (bool, error) {
+	if !s.client.IsEnabled() || !user.TelegramChatID.Valid {
+		return false, nil
+	}
+
+	message := fmt.Sprintf(
+		"Для сброса пароля перейдите по ссылке:\n%s\n\nКод сброса: %s\n"+
+			"Если вы не запрашивали сброс пароля, просто проигнорируйте это сообщение.",
+		resetURL, token,
+	)
+	if err := s.client.SendMessage(ctx, user.TelegramChatID.Int64, message); err != nil {
+		return false, fmt.Errorf("error while sending telegram password reset: %w", err)
+	}
+
+	return true, nil
+}
+
+func formatRatingEventMessage(event dto.RatingEvent) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s — %s\n", event.Direction.UniversityName, event.Direction.Name)
+
+	for _, alert := range event.Alerts {
+		fmt.Fprintf(
+			&b, "Сработало правило: %s %s %d, сейчас %d\n",
+			alertMetricNames[alert.Metric], alertOperatorSigns[alert.Operator], alert.Threshold, alert.Value,
+		)
+	}
+
+	for _, change := range event.Changes {
+		switch change {
+		case dto.PositionChange:
+			fmt.Fprintf(&b, "Позиция: %d → %d\n", event.Previous.Position, event.Current.Position)
+		case dto.SubmittedConsentUpperChange:
+			fmt.Fprintf(
+				&b, "Согласий выше: %d → %d\n",
+				event.Previous.SubmittedConsentUpper, event.Current.SubmittedConsentUpper,
+			)
+		}
+	}
+
+	if event.Current.IsWithinBudget {
+		b.WriteString("Вы проходите на бюджет")
+	} else {
+		b.WriteString("Вы не проходите на бюджет")
+	}
+
+	return b.String()
+}
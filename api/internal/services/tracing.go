@@ -0,0 +1,911 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+)
+
+func userIDAttribute(userID uint) attribute.KeyValue {
+	return attribute.Int64("user_id", int64(userID))
+}
+
+// endSpan ends span of service call. Only failures of api are recorded as errors of span,
+// client errors (e.g. validation) and users missing in rating lists are expected results.
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, ErrUserNotFoundInRatingList) &&
+		apierrors.From(err).Kind.Status() >= http.StatusInternalServerError {
+		tracing.RecordError(span, err)
+	}
+
+	span.End()
+}
+
+type tracedPasswordResetNotifier struct {
+	PasswordResetNotifier
+	name string
+}
+
+func (s tracedPasswordResetNotifier) SendPasswordReset(
+	ctx context.Context,
+	user *models.User,
+	resetURL string,
+	token string,
+) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "services."+s.name+".SendPasswordReset", userIDAttribute(user.ID))
+	defer func() { endSpan(span, err) }()
+
+	return s.PasswordResetNotifier.SendPasswordReset(ctx, user, resetURL, token)
+}
+
+type tracedAuthorization struct {
+	Authorization
+}
+
+func (s tracedAuthorization) SignUpUser(
+	ctx context.Context,
+	userData dto.SigningUp,
+	client dto.ClientInfo,
+) (_ uint, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.SignUpUser")
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.SignUpUser(ctx, userData, client)
+}
+
+func (s tracedAuthorization) GenerateTokens(
+	ctx context.Context,
+	userCredentials dto.UserCredentials,
+	client dto.ClientInfo,
+) (_ *dto.SignInResult, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.GenerateTokens")
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.GenerateTokens(ctx, userCredentials, client)
+}
+
+func (s tracedAuthorization) SignInTwoFactor(
+	ctx context.Context,
+	data dto.TwoFactorSigningIn,
+	client dto.ClientInfo,
+) (_ *dto.AuthorizationTokens, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.SignInTwoFactor")
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.SignInTwoFactor(ctx, data, client)
+}
+
+func (s tracedAuthorization) GenerateTokensForUser(
+	ctx context.Context,
+	userID uint,
+	client dto.ClientInfo,
+) (_ *dto.SignInResult, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.GenerateTokensForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.GenerateTokensForUser(ctx, userID, client)
+}
+
+func (s tracedAuthorization) RefreshTokens(
+	ctx context.Context,
+	refreshToken string,
+	client dto.ClientInfo,
+) (_ *dto.AuthorizationTokens, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.RefreshTokens")
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.RefreshTokens(ctx, refreshToken, client)
+}
+
+func (s tracedAuthorization) LogoutUser(
+	ctx context.Context,
+	userID uint,
+	accessToken string,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.LogoutUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.LogoutUser(ctx, userID, accessToken, client)
+}
+
+func (s tracedAuthorization) GetSessions(
+	ctx context.Context,
+	userID uint,
+	currentSessionID string,
+) (_ []dto.Session, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.GetSessions", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.GetSessions(ctx, userID, currentSessionID)
+}
+
+func (s tracedAuthorization) RevokeSession(ctx context.Context, userID uint, sessionID string) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.RevokeSession", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.RevokeSession(ctx, userID, sessionID)
+}
+
+func (s tracedAuthorization) RevokeSessions(ctx context.Context, userID uint) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.RevokeSessions", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.RevokeSessions(ctx, userID)
+}
+
+func (s tracedAuthorization) ChangePassword(
+	ctx context.Context,
+	userID uint,
+	data dto.PasswordChanging,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.ChangePassword", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.ChangePassword(ctx, userID, data, client)
+}
+
+func (s tracedAuthorization) IsTokenRevoked(ctx context.Context, tokenID string) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "services.Authorization.IsTokenRevoked")
+	defer func() { endSpan(span, err) }()
+
+	return s.Authorization.IsTokenRevoked(ctx, tokenID)
+}
+
+type tracedPasswordReset struct {
+	PasswordReset
+}
+
+func (s tracedPasswordReset) RequestReset(ctx context.Context, data dto.PasswordResetRequesting) (err error) {
+	ctx, span := tracing.Start(ctx, "services.PasswordReset.RequestReset")
+	defer func() { endSpan(span, err) }()
+
+	return s.PasswordReset.RequestReset(ctx, data)
+}
+
+func (s tracedPasswordReset) ConfirmReset(ctx context.Context, data dto.PasswordResetConfirming) (err error) {
+	ctx, span := tracing.Start(ctx, "services.PasswordReset.ConfirmReset")
+	defer func() { endSpan(span, err) }()
+
+	return s.PasswordReset.ConfirmReset(ctx, data)
+}
+
+type tracedOIDC struct {
+	OIDC
+}
+
+func (s tracedOIDC) Authorize(
+	ctx context.Context,
+	providerName string,
+	userID uint,
+) (_ *dto.OIDCAuthorizationURL, err error) {
+	ctx, span := tracing.Start(ctx, "services.OIDC.Authorize", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.OIDC.Authorize(ctx, providerName, userID)
+}
+
+func (s tracedOIDC) SignIn(
+	ctx context.Context,
+	providerName string,
+	data dto.OIDCCallback,
+	client dto.ClientInfo,
+) (_ *dto.SignInResult, err error) {
+	ctx, span := tracing.Start(ctx, "services.OIDC.SignIn")
+	defer func() { endSpan(span, err) }()
+
+	return s.OIDC.SignIn(ctx, providerName, data, client)
+}
+
+func (s tracedOIDC) Link(ctx context.Context, userID uint, providerName string, data dto.OIDCCallback) (err error) {
+	ctx, span := tracing.Start(ctx, "services.OIDC.Link", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.OIDC.Link(ctx, userID, providerName, data)
+}
+
+func (s tracedOIDC) GetIdentities(ctx context.Context, userID uint) (_ []dto.UserIdentity, err error) {
+	ctx, span := tracing.Start(ctx, "services.OIDC.GetIdentities", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.OIDC.GetIdentities(ctx, userID)
+}
+
+func (s tracedOIDC) Unlink(ctx context.Context, userID uint, providerName string) (err error) {
+	ctx, span := tracing.Start(ctx, "services.OIDC.Unlink", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.OIDC.Unlink(ctx, userID, providerName)
+}
+
+type tracedTwoFactor struct {
+	TwoFactor
+}
+
+func (s tracedTwoFactor) GetStatus(ctx context.Context, userID uint) (_ *dto.TwoFactorStatus, err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.GetStatus", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.GetStatus(ctx, userID)
+}
+
+func (s tracedTwoFactor) Enroll(ctx context.Context, userID uint) (_ *dto.TOTPEnrollment, err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.Enroll", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.Enroll(ctx, userID)
+}
+
+func (s tracedTwoFactor) Confirm(
+	ctx context.Context,
+	userID uint,
+	data dto.TwoFactorCode,
+) (_ *dto.BackupCodes, err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.Confirm", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.Confirm(ctx, userID, data)
+}
+
+func (s tracedTwoFactor) Disable(ctx context.Context, userID uint, data dto.TwoFactorCode) (err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.Disable", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.Disable(ctx, userID, data)
+}
+
+func (s tracedTwoFactor) RegenerateBackupCodes(
+	ctx context.Context,
+	userID uint,
+	data dto.TwoFactorCode,
+) (_ *dto.BackupCodes, err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.RegenerateBackupCodes", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.RegenerateBackupCodes(ctx, userID, data)
+}
+
+func (s tracedTwoFactor) IsEnabled(ctx context.Context, userID uint) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.IsEnabled", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.IsEnabled(ctx, userID)
+}
+
+func (s tracedTwoFactor) VerifyCode(ctx context.Context, userID uint, code string) (err error) {
+	ctx, span := tracing.Start(ctx, "services.TwoFactor.VerifyCode", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.TwoFactor.VerifyCode(ctx, userID, code)
+}
+
+type tracedPersonalAccessToken struct {
+	PersonalAccessToken
+}
+
+func (s tracedPersonalAccessToken) Create(
+	ctx context.Context,
+	userID uint,
+	data dto.PersonalAccessTokenCreating,
+) (_ *dto.CreatedPersonalAccessToken, err error) {
+	ctx, span := tracing.Start(ctx, "services.PersonalAccessToken.Create", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.PersonalAccessToken.Create(ctx, userID, data)
+}
+
+func (s tracedPersonalAccessToken) GetForUser(
+	ctx context.Context,
+	userID uint,
+) (_ []dto.PersonalAccessToken, err error) {
+	ctx, span := tracing.Start(ctx, "services.PersonalAccessToken.GetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.PersonalAccessToken.GetForUser(ctx, userID)
+}
+
+func (s tracedPersonalAccessToken) Revoke(ctx context.Context, userID uint, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "services.PersonalAccessToken.Revoke", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.PersonalAccessToken.Revoke(ctx, userID, id)
+}
+
+func (s tracedPersonalAccessToken) AuthenticatePersonalAccessToken(
+	ctx context.Context,
+	token string,
+) (_ *authorization.PersonalAccessTokenClaims, err error) {
+	ctx, span := tracing.Start(ctx, "services.PersonalAccessToken.AuthenticatePersonalAccessToken")
+	defer func() { endSpan(span, err) }()
+
+	return s.PersonalAccessToken.AuthenticatePersonalAccessToken(ctx, token)
+}
+
+type tracedUser struct {
+	User
+}
+
+func (s tracedUser) GetUsername(ctx context.Context, id uint) (_ *dto.Username, err error) {
+	ctx, span := tracing.Start(ctx, "services.User.GetUsername")
+	defer func() { endSpan(span, err) }()
+
+	return s.User.GetUsername(ctx, id)
+}
+
+func (s tracedUser) GetProfile(ctx context.Context, id uint) (_ *dto.UserProfile, err error) {
+	ctx, span := tracing.Start(ctx, "services.User.GetProfile")
+	defer func() { endSpan(span, err) }()
+
+	return s.User.GetProfile(ctx, id)
+}
+
+func (s tracedUser) PatchProfile(
+	ctx context.Context,
+	id uint,
+	data dto.UserPatching,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.User.PatchProfile")
+	defer func() { endSpan(span, err) }()
+
+	return s.User.PatchProfile(ctx, id, data, client)
+}
+
+type tracedAuditLog struct {
+	AuditLog
+}
+
+func (s tracedAuditLog) Record(
+	ctx context.Context,
+	userID uint,
+	action string,
+	client dto.ClientInfo,
+	metadata map[string]string,
+) {
+	ctx, span := tracing.Start(ctx, "services.AuditLog.Record", userIDAttribute(userID))
+	defer span.End()
+
+	s.AuditLog.Record(ctx, userID, action, client, metadata)
+}
+
+func (s tracedAuditLog) GetForUser(ctx context.Context, userID uint) (_ []dto.AuditLogRecord, err error) {
+	ctx, span := tracing.Start(ctx, "services.AuditLog.GetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AuditLog.GetForUser(ctx, userID)
+}
+
+func (s tracedAuditLog) Find(ctx context.Context, query dto.AuditLogQuery) (_ []dto.AuditLogRecord, err error) {
+	ctx, span := tracing.Start(ctx, "services.AuditLog.Find")
+	defer func() { endSpan(span, err) }()
+
+	return s.AuditLog.Find(ctx, query)
+}
+
+type tracedAccount struct {
+	Account
+}
+
+func (s tracedAccount) Export(ctx context.Context, userID uint, client dto.ClientInfo) (_ *dto.UserExport, err error) {
+	ctx, span := tracing.Start(ctx, "services.Account.Export", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Account.Export(ctx, userID, client)
+}
+
+func (s tracedAccount) Delete(ctx context.Context, userID uint, client dto.ClientInfo) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Account.Delete", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Account.Delete(ctx, userID, client)
+}
+
+type tracedAdmin struct {
+	Admin
+}
+
+func (s tracedAdmin) SetRole(
+	ctx context.Context,
+	adminID uint,
+	userID uint,
+	data dto.RoleSetting,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Admin.SetRole", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Admin.SetRole(ctx, adminID, userID, data, client)
+}
+
+func (s tracedAdmin) PatchUniversity(
+	ctx context.Context,
+	adminID uint,
+	id uint,
+	data dto.UniversityPatching,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Admin.PatchUniversity")
+	defer func() { endSpan(span, err) }()
+
+	return s.Admin.PatchUniversity(ctx, adminID, id, data, client)
+}
+
+func (s tracedAdmin) PatchDirection(
+	ctx context.Context,
+	adminID uint,
+	id uint,
+	data dto.DirectionPatching,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Admin.PatchDirection")
+	defer func() { endSpan(span, err) }()
+
+	return s.Admin.PatchDirection(ctx, adminID, id, data, client)
+}
+
+func (s tracedAdmin) PurgeRatingLists(
+	ctx context.Context,
+	adminID uint,
+	client dto.ClientInfo,
+) (_ *dto.CachePurged, err error) {
+	ctx, span := tracing.Start(ctx, "services.Admin.PurgeRatingLists")
+	defer func() { endSpan(span, err) }()
+
+	return s.Admin.PurgeRatingLists(ctx, adminID, client)
+}
+
+type tracedParsing struct {
+	Parsing
+}
+
+func (s tracedParsing) ParseRating(
+	ctx context.Context,
+	universityName string,
+	ratingURL string,
+	userSnils string,
+) (_ *dto.ParsingResult, err error) {
+	ctx, span := tracing.Start(ctx, "services.Parsing.ParseRating")
+	defer func() { endSpan(span, err) }()
+
+	return s.Parsing.ParseRating(ctx, universityName, ratingURL, userSnils)
+}
+
+type tracedUniversity struct {
+	University
+}
+
+func (s tracedUniversity) GetAll(ctx context.Context) (_ []dto.University, err error) {
+	ctx, span := tracing.Start(ctx, "services.University.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	return s.University.GetAll(ctx)
+}
+
+func (s tracedUniversity) GetByID(ctx context.Context, id uint) (_ *models.University, err error) {
+	ctx, span := tracing.Start(ctx, "services.University.GetByID")
+	defer func() { endSpan(span, err) }()
+
+	return s.University.GetByID(ctx, id)
+}
+
+func (s tracedUniversity) GetByIDs(ctx context.Context, ids []uint) (_ []dto.University, err error) {
+	ctx, span := tracing.Start(ctx, "services.University.GetByIDs")
+	defer func() { endSpan(span, err) }()
+
+	return s.University.GetByIDs(ctx, ids)
+}
+
+func (s tracedUniversity) GetForUser(ctx context.Context, userID uint) (_ []dto.University, err error) {
+	ctx, span := tracing.Start(ctx, "services.University.GetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.University.GetForUser(ctx, userID)
+}
+
+func (s tracedUniversity) SetForUser(ctx context.Context, userID uint, universityIDs dto.IDs) (err error) {
+	ctx, span := tracing.Start(ctx, "services.University.SetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.University.SetForUser(ctx, userID, universityIDs)
+}
+
+type tracedDirection struct {
+	Direction
+}
+
+func (s tracedDirection) GetAll(
+	ctx context.Context,
+	query dto.DirectionQuery,
+) (_ []dto.UniversityDirections, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetAll(ctx, query)
+}
+
+func (s tracedDirection) Find(ctx context.Context, query dto.DirectionQuery) (_ *dto.DirectionsPage, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.Find")
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.Find(ctx, query)
+}
+
+func (s tracedDirection) GetByID(ctx context.Context, id uint) (_ *models.Direction, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetByID")
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetByID(ctx, id)
+}
+
+func (s tracedDirection) GetForUser(ctx context.Context, userID uint) (_ []dto.UniversityDirections, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetForUser(ctx, userID)
+}
+
+func (s tracedDirection) GetForUserWithRating(
+	ctx context.Context,
+	userID uint,
+) (_ []dto.UniversityDirectionsWithRating, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetForUserWithRating", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetForUserWithRating(ctx, userID)
+}
+
+func (s tracedDirection) SetForUser(
+	ctx context.Context,
+	userID uint,
+	directionIDs dto.IDs,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.SetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.SetForUser(ctx, userID, directionIDs, client)
+}
+
+func (s tracedDirection) GetTrackedForUser(ctx context.Context, userID uint) (_ []dto.UniversityDirection, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetTrackedForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetTrackedForUser(ctx, userID)
+}
+
+func (s tracedDirection) GetTrackedForUserByID(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+) (_ *dto.UniversityDirection, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetTrackedForUserByID", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetTrackedForUserByID(ctx, userID, directionID)
+}
+
+func (s tracedDirection) GetForUniversity(
+	ctx context.Context,
+	universityID uint,
+) (_ []dto.UniversityDirection, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetForUniversity")
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetForUniversity(ctx, universityID)
+}
+
+func (s tracedDirection) GetForUniversities(
+	ctx context.Context,
+	universityIDs []uint,
+) (_ []dto.UniversityDirection, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.GetForUniversities")
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.GetForUniversities(ctx, universityIDs)
+}
+
+func (s tracedDirection) AddForUser(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+	client dto.ClientInfo,
+) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.AddForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.AddForUser(ctx, userID, directionID, client)
+}
+
+func (s tracedDirection) RemoveForUser(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+	client dto.ClientInfo,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.RemoveForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.RemoveForUser(ctx, userID, directionID, client)
+}
+
+func (s tracedDirection) RefreshRatings(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Direction.RefreshRatings")
+	defer func() { endSpan(span, err) }()
+
+	return s.Direction.RefreshRatings(ctx)
+}
+
+type tracedLiveUpdates struct {
+	LiveUpdates
+}
+
+func (s tracedLiveUpdates) Publish(
+	ctx context.Context,
+	userID uint,
+	directions []dto.UniversityDirectionsWithRating,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.LiveUpdates.Publish", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.LiveUpdates.Publish(ctx, userID, directions)
+}
+
+func (s tracedLiveUpdates) GetLatest(ctx context.Context, userID uint) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "services.LiveUpdates.GetLatest", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.LiveUpdates.GetLatest(ctx, userID)
+}
+
+func (s tracedLiveUpdates) Subscribe(ctx context.Context, userID uint) (_ <-chan string, err error) {
+	ctx, span := tracing.Start(ctx, "services.LiveUpdates.Subscribe", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.LiveUpdates.Subscribe(ctx, userID)
+}
+
+type tracedRatingHistory struct {
+	RatingHistory
+}
+
+func (s tracedRatingHistory) Track(
+	ctx context.Context,
+	userID uint,
+	directions []dto.DirectionWithParsingResult,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "services.RatingHistory.Track", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.RatingHistory.Track(ctx, userID, directions)
+}
+
+func (s tracedRatingHistory) GetForDirection(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+	limit int,
+) (_ []dto.RatingHistoryRecord, err error) {
+	ctx, span := tracing.Start(ctx, "services.RatingHistory.GetForDirection", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.RatingHistory.GetForDirection(ctx, userID, directionID, limit)
+}
+
+func (s tracedRatingHistory) GetForDirections(
+	ctx context.Context,
+	userID uint,
+	directionIDs []uint,
+	limit int,
+) (_ map[uint][]dto.RatingHistoryRecord, err error) {
+	ctx, span := tracing.Start(ctx, "services.RatingHistory.GetForDirections", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.RatingHistory.GetForDirections(ctx, userID, directionIDs, limit)
+}
+
+type tracedAlertRule struct {
+	AlertRule
+}
+
+func (s tracedAlertRule) Create(
+	ctx context.Context,
+	userID uint,
+	data dto.AlertRuleCreating,
+) (_ *dto.AlertRule, err error) {
+	ctx, span := tracing.Start(ctx, "services.AlertRule.Create", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AlertRule.Create(ctx, userID, data)
+}
+
+func (s tracedAlertRule) Get(ctx context.Context, userID uint, id uint) (_ *dto.AlertRule, err error) {
+	ctx, span := tracing.Start(ctx, "services.AlertRule.Get", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AlertRule.Get(ctx, userID, id)
+}
+
+func (s tracedAlertRule) GetForUser(ctx context.Context, userID uint) (_ []dto.AlertRule, err error) {
+	ctx, span := tracing.Start(ctx, "services.AlertRule.GetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AlertRule.GetForUser(ctx, userID)
+}
+
+func (s tracedAlertRule) Update(
+	ctx context.Context,
+	userID uint,
+	id uint,
+	data dto.AlertRuleUpdating,
+) (_ *dto.AlertRule, err error) {
+	ctx, span := tracing.Start(ctx, "services.AlertRule.Update", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AlertRule.Update(ctx, userID, id, data)
+}
+
+func (s tracedAlertRule) Delete(ctx context.Context, userID uint, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "services.AlertRule.Delete", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AlertRule.Delete(ctx, userID, id)
+}
+
+func (s tracedAlertRule) Evaluate(
+	ctx context.Context,
+	userID uint,
+	directionID uint,
+	previous dto.ParsingResult,
+	current dto.ParsingResult,
+) (_ []dto.TriggeredAlert, err error) {
+	ctx, span := tracing.Start(ctx, "services.AlertRule.Evaluate", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.AlertRule.Evaluate(ctx, userID, directionID, previous, current)
+}
+
+type tracedNotification struct {
+	Notification
+}
+
+func (s tracedNotification) Notify(ctx context.Context, event dto.RatingEvent) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Notification.Notify")
+	defer func() { endSpan(span, err) }()
+
+	return s.Notification.Notify(ctx, event)
+}
+
+type tracedWebhook struct {
+	Webhook
+}
+
+func (s tracedWebhook) Create(
+	ctx context.Context,
+	userID uint,
+	data dto.WebhookCreating,
+) (_ *dto.WebhookCreated, err error) {
+	ctx, span := tracing.Start(ctx, "services.Webhook.Create", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Webhook.Create(ctx, userID, data)
+}
+
+func (s tracedWebhook) GetForUser(ctx context.Context, userID uint) (_ []dto.Webhook, err error) {
+	ctx, span := tracing.Start(ctx, "services.Webhook.GetForUser", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Webhook.GetForUser(ctx, userID)
+}
+
+func (s tracedWebhook) Delete(ctx context.Context, userID uint, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Webhook.Delete", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Webhook.Delete(ctx, userID, id)
+}
+
+func (s tracedWebhook) GetDeliveries(
+	ctx context.Context,
+	userID uint,
+	webhookID uint,
+) (_ []dto.WebhookDelivery, err error) {
+	ctx, span := tracing.Start(ctx, "services.Webhook.GetDeliveries", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Webhook.GetDeliveries(ctx, userID, webhookID)
+}
+
+func (s tracedWebhook) DeliverPending(ctx context.Context) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "services.Webhook.DeliverPending")
+	defer func() { endSpan(span, err) }()
+
+	return s.Webhook.DeliverPending(ctx)
+}
+
+type tracedEmail struct {
+	Email
+}
+
+func (s tracedEmail) RequestVerification(ctx context.Context, userID uint, data dto.EmailAddress) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Email.RequestVerification", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Email.RequestVerification(ctx, userID, data)
+}
+
+func (s tracedEmail) Verify(ctx context.Context, token string) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Email.Verify")
+	defer func() { endSpan(span, err) }()
+
+	return s.Email.Verify(ctx, token)
+}
+
+func (s tracedEmail) PatchSettings(ctx context.Context, userID uint, data dto.EmailSettings) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Email.PatchSettings", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Email.PatchSettings(ctx, userID, data)
+}
+
+func (s tracedEmail) Unsubscribe(ctx context.Context, token string) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Email.Unsubscribe")
+	defer func() { endSpan(span, err) }()
+
+	return s.Email.Unsubscribe(ctx, token)
+}
+
+func (s tracedEmail) Notify(ctx context.Context, event dto.RatingEvent) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Email.Notify")
+	defer func() { endSpan(span, err) }()
+
+	return s.Email.Notify(ctx, event)
+}
+
+type tracedTelegram struct {
+	Telegram
+}
+
+func (s tracedTelegram) CreateLinkCode(ctx context.Context, userID uint) (_ *dto.TelegramLinkCode, err error) {
+	ctx, span := tracing.Start(ctx, "services.Telegram.CreateLinkCode", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Telegram.CreateLinkCode(ctx, userID)
+}
+
+func (s tracedTelegram) Link(ctx context.Context, code string, chatID int64) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Telegram.Link")
+	defer func() { endSpan(span, err) }()
+
+	return s.Telegram.Link(ctx, code, chatID)
+}
+
+func (s tracedTelegram) Unlink(ctx context.Context, userID uint) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Telegram.Unlink", userIDAttribute(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.Telegram.Unlink(ctx, userID)
+}
+
+func (s tracedTelegram) GetUserIDByChatID(ctx context.Context, chatID int64) (_ uint, err error) {
+	ctx, span := tracing.Start(ctx, "services.Telegram.GetUserIDByChatID")
+	defer func() { endSpan(span, err) }()
+
+	return s.Telegram.GetUserIDByChatID(ctx, chatID)
+}
+
+func (s tracedTelegram) Notify(ctx context.Context, event dto.RatingEvent) (err error) {
+	ctx, span := tracing.Start(ctx, "services.Telegram.Notify")
+	defer func() { endSpan(span, err) }()
+
+	return s.Telegram.Notify(ctx, event)
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/totp"
+)
+
+const (
+	backupCodeLength    = 5
+	backupCodeSeparator = "-"
+)
+
+type TwoFactorImpl struct {
+	twoFactorRepository repository.TwoFactor
+	userRepository      repository.User
+	twoFactorCache      cache.TwoFactor
+	totpSecrets         *encryption.TOTPSecrets
+	cfg                 *config.TwoFactor
+	logger              *logging.Logger
+}
+
+func NewTwoFactorImpl(
+	twoFactorRepository repository.TwoFactor,
+	userRepository repository.User,
+	twoFactorCache cache.TwoFactor,
+	totpSecrets *encryption.TOTPSecrets,
+) *TwoFactorImpl {
+	return &TwoFactorImpl{
+		twoFactorRepository: twoFactorRepository,
+		userRepository:      userRepository,
+		twoFactorCache:      twoFactorCache,
+		totpSecrets:         totpSecrets,
+		cfg:                 config.Get().TwoFactor,
+		logger:              logging.NewLogger("two factor services"),
+	}
+}
+
+func (s *TwoFactorImpl) GetStatus(ctx context.Context, userID uint) (*dto.TwoFactorStatus, error) {
+	enabled, err := s.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !enabled {
+		return &dto.TwoFactorStatus{}, nil
+	}
+
+	left, err := s.twoFactorRepository.CountBackupCodes(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while counting backup codes by repository: %w", err)
+	}
+
+	return &dto.TwoFactorStatus{
+		Enabled:         true,
+		BackupCodesLeft: left,
+	}, nil
+}
+
+// Enroll generates new TOTP secret of user. Secret isn't required by sign in until it is
+// confirmed by code, enrolling again replaces not confirmed secret.
+func (s *TwoFactorImpl) Enroll(ctx context.Context, userID uint) (*dto.TOTPEnrollment, error) {
+	enabled, err := s.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		return nil, TOTPAlreadyEnabledError
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user by repository: %w", err)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error while generating totp secret: %w", err)
+	}
+
+	encrypted, err := s.totpSecrets.Encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorRepository.SaveTOTP(ctx, userID, encrypted); err != nil {
+		return nil, fmt.Errorf("error while saving totp by repository: %w", err)
+	}
+
+	return &dto.TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.cfg.Issuer, user.Username, secret),
+	}, nil
+}
+
+// Confirm enables TOTP of user if code of enrolled secret is correct and returns backup codes.
+func (s *TwoFactorImpl) Confirm(ctx context.Context, userID uint, data dto.TwoFactorCode) (*dto.BackupCodes, error) {
+	userTOTP, err := s.twoFactorRepository.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, TOTPNotEnrolledError
+		}
+
+		return nil, fmt.Errorf("error while getting totp by repository: %w", err)
+	}
+
+	if userTOTP.Enabled {
+		return nil, TOTPAlreadyEnabledError
+	}
+
+	if err := s.verifyTOTP(ctx, userTOTP, data.Code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.generateBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorRepository.EnableTOTP(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("error while enabling totp by repository: %w", err)
+	}
+
+	return &dto.BackupCodes{BackupCodes: codes}, nil
+}
+
+// Disable disables TOTP of user, it requires TOTP or backup code.
+func (s *TwoFactorImpl) Disable(ctx context.Context, userID uint, data dto.TwoFactorCode) error {
+	if err := s.VerifyCode(ctx, userID, data.Code); err != nil {
+		return err
+	}
+
+	if err := s.twoFactorRepository.DeleteTOTP(ctx, userID); err != nil {
+		return fmt.Errorf("error while deleting totp by repository: %w", err)
+	}
+
+	return nil
+}
+
+// RegenerateBackupCodes replaces backup codes of user, it requires TOTP code.
+func (s *TwoFactorImpl) RegenerateBackupCodes(
+	ctx context.Context,
+	userID uint,
+	data dto.TwoFactorCode,
+) (*dto.BackupCodes, error) {
+	userTOTP, err := s.getEnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTOTP(ctx, userTOTP, data.Code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.generateBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorRepository.ReplaceBackupCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("error while replacing backup codes by repository: %w", err)
+	}
+
+	return &dto.BackupCodes{BackupCodes: codes}, nil
+}
+
+func (s *TwoFactorImpl) IsEnabled(ctx context.Context, userID uint) (bool, error) {
+	userTOTP, err := s.twoFactorRepository.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error while getting totp by repository: %w", err)
+	}
+
+	return userTOTP.Enabled, nil
+}
+
+// VerifyCode checks TOTP code or backup code of user. Both kinds of codes can be used only once.
+func (s *TwoFactorImpl) VerifyCode(ctx context.Context, userID uint, code string) error {
+	userTOTP, err := s.getEnabledTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if len(strings.ReplaceAll(code, " ", "")) == totp.Digits {
+		return s.verifyTOTP(ctx, userTOTP, code)
+	}
+
+	if err := s.twoFactorRepository.UseBackupCode(ctx, userID, hashToken(normalizeBackupCode(code))); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return InvalidTwoFactorCodeError
+		}
+
+		return fmt.Errorf("error while using backup code by repository: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TwoFactorImpl) getEnabledTOTP(ctx context.Context, userID uint) (*models.UserTOTP, error) {
+	userTOTP, err := s.twoFactorRepository.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, TOTPNotEnabledError
+		}
+
+		return nil, fmt.Errorf("error while getting totp by repository: %w", err)
+	}
+
+	if !userTOTP.Enabled {
+		return nil, TOTPNotEnabledError
+	}
+
+	return userTOTP, nil
+}
+
+// verifyTOTP checks TOTP code, time step of accepted code is remembered until it leaves
+// skew window, so intercepted code can't be replayed.
+func (s *TwoFactorImpl) verifyTOTP(ctx context.Context, userTOTP *models.UserTOTP, code string) error {
+	secret, err := s.totpSecrets.Decrypt(userTOTP.Secret)
+	if err != nil {
+		return err
+	}
+
+	step, err := totp.Validate(secret, code, time.Now(), s.cfg.Skew)
+	if err != nil {
+		return InvalidTwoFactorCodeError
+	}
+
+	ttl := time.Duration(2*s.cfg.Skew+1) * totp.Period
+
+	first, err := s.twoFactorCache.UseCode(ctx, userTOTP.UserID, step, ttl)
+	if err != nil {
+		return err
+	}
+
+	if !first {
+		return InvalidTwoFactorCodeError
+	}
+
+	return nil
+}
+
+// generateBackupCodes returns backup codes shown to user and their hashes for storing.
+func (s *TwoFactorImpl) generateBackupCodes() ([]string, []string, error) {
+	codes := make([]string, 0, s.cfg.BackupCodes)
+	hashes := make([]string, 0, s.cfg.BackupCodes)
+
+	for i := 0; i < s.cfg.BackupCodes; i++ {
+		code, err := random.Hex(backupCodeLength)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error while generating backup code: %w", err)
+		}
+
+		codes = append(codes, code[:backupCodeLength]+backupCodeSeparator+code[backupCodeLength:])
+		hashes = append(hashes, hashToken(code))
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeBackupCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(backupCodeSeparator, "", " ", "").Replace(code))
+}
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
@@ -22,8 +24,8 @@ func NewUniversityImpl(universityRepository repository.University) *UniversityIm
 	}
 }
 
-func (s *UniversityImpl) GetAll() ([]dto.University, error) {
-	universities, err := s.universityRepository.GetAll()
+func (s *UniversityImpl) GetAll(ctx context.Context) ([]dto.University, error) {
+	universities, err := s.universityRepository.GetAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting all universities by repository: %w", err)
 	}
@@ -31,17 +33,30 @@ func (s *UniversityImpl) GetAll() ([]dto.University, error) {
 	return mapRepositoryUniversitiesResultToDTOs(universities), nil
 }
 
-func (s *UniversityImpl) GetByID(id uint) (*models.University, error) {
-	university, err := s.universityRepository.GetByID(id)
+func (s *UniversityImpl) GetByID(ctx context.Context, id uint) (*models.University, error) {
+	university, err := s.universityRepository.GetByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, UniversityNotFoundError
+		}
+
 		return nil, fmt.Errorf("error while getting university by ID from repository: %w", err)
 	}
 
 	return university, nil
 }
 
-func (s *UniversityImpl) GetForUser(userID uint) ([]dto.University, error) {
-	universities, err := s.universityRepository.GetForUser(userID)
+func (s *UniversityImpl) GetByIDs(ctx context.Context, ids []uint) ([]dto.University, error) {
+	universities, err := s.universityRepository.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting universities by IDs from repository: %w", err)
+	}
+
+	return mapRepositoryUniversitiesResultToDTOs(universities), nil
+}
+
+func (s *UniversityImpl) GetForUser(ctx context.Context, userID uint) ([]dto.University, error) {
+	universities, err := s.universityRepository.GetForUser(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting user universities from repository: %w", err)
 	}
@@ -62,12 +77,16 @@ func mapRepositoryUniversitiesResultToDTOs(universities []rdto.University) []dto
 	return result
 }
 
-func (s *UniversityImpl) SetForUser(userID uint, universityIDs dto.IDs) error {
-	if err := s.universityRepository.Clear(userID); err != nil {
+func (s *UniversityImpl) SetForUser(ctx context.Context, userID uint, universityIDs dto.IDs) error {
+	if err := s.universityRepository.Clear(ctx, userID); err != nil {
 		return fmt.Errorf("error while clearing user universities by repository: %w", err)
 	}
 
-	if err := s.universityRepository.SetForUser(userID, universityIDs); err != nil {
+	if err := s.universityRepository.SetForUser(ctx, userID, universityIDs); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return UniversityNotFoundError
+		}
+
 		return fmt.Errorf("error while setting universities for user from repository: %w", err)
 	}
 
@@ -1,27 +1,46 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/cache"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
 	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 )
 
 type UserImpl struct {
-	userRepository repository.User
-	logger         *logging.Logger
+	userRepository          repository.User
+	ratingHistoryRepository repository.RatingHistory
+	liveUpdatesCache        cache.LiveUpdates
+	auditLogService         AuditLog
+	snils                   *encryption.Snils
+	logger                  *logging.Logger
 }
 
-func NewUserImpl(userRepository repository.User) *UserImpl {
+func NewUserImpl(
+	userRepository repository.User,
+	ratingHistoryRepository repository.RatingHistory,
+	liveUpdatesCache cache.LiveUpdates,
+	auditLogService AuditLog,
+	snils *encryption.Snils,
+) *UserImpl {
 	return &UserImpl{
-		userRepository: userRepository,
-		logger:         logging.NewLogger("user services"),
+		userRepository:          userRepository,
+		ratingHistoryRepository: ratingHistoryRepository,
+		liveUpdatesCache:        liveUpdatesCache,
+		auditLogService:         auditLogService,
+		snils:                   snils,
+		logger:                  logging.NewLogger("user services"),
 	}
 }
 
-func (s *UserImpl) GetUsername(id uint) (*dto.Username, error) {
-	username, err := s.userRepository.GetUsername(id)
+func (s *UserImpl) GetUsername(ctx context.Context, id uint) (*dto.Username, error) {
+	username, err := s.userRepository.GetUsername(ctx, id)
 	if err != nil {
 		s.logger.Error(err)
 
@@ -31,8 +50,9 @@ func (s *UserImpl) GetUsername(id uint) (*dto.Username, error) {
 	return (*dto.Username)(username), nil
 }
 
-func (s *UserImpl) GetProfile(id uint) (*dto.UserProfile, error) {
-	userProfile, err := s.userRepository.GetProfile(id)
+// GetProfile returns user profile with masked SNILS, so SNILS isn't decrypted.
+func (s *UserImpl) GetProfile(ctx context.Context, id uint) (*dto.UserProfile, error) {
+	userProfile, err := s.userRepository.GetProfile(ctx, id)
 	if err != nil {
 		s.logger.Error(err)
 
@@ -41,3 +61,76 @@ func (s *UserImpl) GetProfile(id uint) (*dto.UserProfile, error) {
 
 	return (*dto.UserProfile)(userProfile), nil
 }
+
+// PatchProfile updates passed user profile fields and records changed ones to audit log
+// without values. SNILS is encrypted and can't be used by another user, its change clears
+// rating history and the latest live update and is recorded separately. The current SNILS
+// of user isn't changed, so patch without other fields does nothing.
+func (s *UserImpl) PatchProfile(ctx context.Context, id uint, data dto.UserPatching, client dto.ClientInfo) error {
+	patching := rdto.UserPatching{
+		FirstName:  data.FirstName,
+		MiddleName: data.MiddleName,
+		LastName:   data.LastName,
+	}
+
+	if data.Snils != nil {
+		snils, err := s.snils.Encrypt(id, *data.Snils)
+		if err != nil {
+			return err
+		}
+
+		userID, err := s.userRepository.GetIDBySnilsHash(ctx, snils.Hash)
+
+		switch {
+		case err == nil && userID != id:
+			return SnilsAlreadyUsedError
+		case err == nil:
+			data.Snils = nil
+		case errors.Is(err, repository.ErrRecordNotFound):
+			patching.Snils = &snils.Ciphertext
+			patching.SnilsHash = &snils.Hash
+			patching.SnilsMask = &snils.Mask
+		default:
+			return fmt.Errorf("error while getting user by snils hash by repository: %w", err)
+		}
+	}
+
+	if data.FirstName == nil && data.MiddleName == nil && data.LastName == nil && data.Snils == nil {
+		return nil
+	}
+
+	if err := s.userRepository.PatchUser(ctx, id, patching); err != nil {
+		if errors.Is(err, repository.ErrSnilsAlreadyUsed) {
+			return SnilsAlreadyUsedError
+		}
+
+		s.logger.Error(err)
+
+		return fmt.Errorf("error while patching user profile: %w", err)
+	}
+
+	s.auditLogService.Record(ctx, id, auditActionUserProfileUpdated, client, map[string]string{
+		"fields": changedFields(map[string]bool{
+			"first_name":  data.FirstName != nil,
+			"middle_name": data.MiddleName != nil,
+			"last_name":   data.LastName != nil,
+			"snils":       data.Snils != nil,
+		}),
+	})
+
+	if data.Snils == nil {
+		return nil
+	}
+
+	s.auditLogService.Record(ctx, id, auditActionUserSnilsChanged, client, nil)
+
+	if err := s.ratingHistoryRepository.Clear(ctx, id); err != nil {
+		return fmt.Errorf("error while clearing user rating history by repository: %w", err)
+	}
+
+	if err := s.liveUpdatesCache.DeleteLatest(ctx, id); err != nil {
+		return fmt.Errorf("error while deleting latest live update from cache: %w", err)
+	}
+
+	return nil
+}
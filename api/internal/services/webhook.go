@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/dto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/models"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository"
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/repository/rdto"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/netguard"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/signature"
+)
+
+const (
+	webhookSecretLength = 32
+	webhookUserAgent    = "rlmp-webhooks/1.0"
+
+	webhookEventHeader     = "X-RLMP-Event"
+	webhookDeliveryHeader  = "X-RLMP-Delivery"
+	webhookSignatureHeader = "X-RLMP-Signature"
+
+	maxWebhookErrorLength = 1024
+)
+
+type WebhookImpl struct {
+	webhookRepository         repository.Webhook
+	webhookDeliveryRepository repository.WebhookDelivery
+	client                    fasthttp.Client
+	cfg                       *config.Webhooks
+	logger                    *logging.Logger
+}
+
+func NewWebhookImpl(
+	webhookRepository repository.Webhook,
+	webhookDeliveryRepository repository.WebhookDelivery,
+) *WebhookImpl {
+	cfg := config.Get().Webhooks
+
+	return &WebhookImpl{
+		webhookRepository:         webhookRepository,
+		webhookDeliveryRepository: webhookDeliveryRepository,
+		client: fasthttp.Client{
+			Name:         webhookUserAgent,
+			Dial:         netguard.NewDialer(cfg.Timeout),
+			ReadTimeout:  cfg.Timeout,
+			WriteTimeout: cfg.Timeout,
+		},
+		cfg:    cfg,
+		logger: logging.NewLogger("webhook services"),
+	}
+}
+
+// Create creates webhook of user, url of webhook must resolve to public addresses only,
+// so deliveries can't reach internal services.
+func (s *WebhookImpl) Create(ctx context.Context, userID uint, data dto.WebhookCreating) (*dto.WebhookCreated, error) {
+	if err := s.validateURL(ctx, data.URL); err != nil {
+		return nil, WebhookURLNotAllowedError.Wrap(err)
+	}
+
+	secret, err := random.Hex(webhookSecretLength)
+	if err != nil {
+		return nil, fmt.Errorf("error while generating webhook secret: %w", err)
+	}
+
+	id, err := s.webhookRepository.Create(ctx, rdto.WebhookCreating{
+		UserID: userID,
+		URL:    data.URL,
+		Secret: secret,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while creating webhook by repository: %w", err)
+	}
+
+	webhook, err := s.webhookRepository.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting created webhook by repository: %w", err)
+	}
+
+	return &dto.WebhookCreated{
+		Webhook: mapWebhookToDTO(*webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+func (s *WebhookImpl) validateURL(ctx context.Context, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	return netguard.ValidateURL(ctx, url)
+}
+
+func (s *WebhookImpl) GetForUser(ctx context.Context, userID uint) ([]dto.Webhook, error) {
+	webhooks, err := s.webhookRepository.GetForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting user webhooks by repository: %w", err)
+	}
+
+	result := make([]dto.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		result = append(result, mapWebhookToDTO(w))
+	}
+
+	return result, nil
+}
+
+func mapWebhookToDTO(w models.Webhook) dto.Webhook {
+	return dto.Webhook{
+		ID:        w.ID,
+		URL:       w.URL,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func (s *WebhookImpl) Delete(ctx context.Context, userID uint, id uint) error {
+	if err := s.webhookRepository.Delete(ctx, userID, id); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return WebhookNotFoundError
+		}
+
+		return fmt.Errorf("error while deleting webhook by repository: %w", err)
+	}
+
+	return nil
+}
+
+func (s *WebhookImpl) GetDeliveries(ctx context.Context, userID uint, webhookID uint) ([]dto.WebhookDelivery, error) {
+	if _, err := s.webhookRepository.GetByID(ctx, userID, webhookID); err != nil {
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, WebhookNotFoundError
+		}
+
+		return nil, fmt.Errorf("error while getting webhook by repository: %w", err)
+	}
+
+	deliveries, err := s.webhookDeliveryRepository.GetForWebhook(ctx, webhookID, s.cfg.DeliveriesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting webhook deliveries by repository: %w", err)
+	}
+
+	result := make([]dto.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, dto.NewWebhookDelivery(d))
+	}
+
+	return result, nil
+}
+
+// DeliverPending sends a batch of due deliveries and returns count of processed ones.
+func (s *WebhookImpl) DeliverPending(ctx context.Context) (int, error) {
+	tasks, err := s.webhookDeliveryRepository.ClaimPending(ctx, s.cfg.BatchSize, 2*s.cfg.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("error while claiming pending deliveries by repository: %w", err)
+	}
+
+	for _, task := range tasks {
+		if err := s.deliver(ctx, task); err != nil {
+			s.logger.Error(err)
+		}
+	}
+
+	return len(tasks), nil
+}
+
+func (s *WebhookImpl) deliver(ctx context.Context, task rdto.WebhookDeliveryTask) error {
+	responseCode, deliveryErr := s.send(ctx, task)
+	if deliveryErr == nil {
+		if err := s.webhookDeliveryRepository.MarkDelivered(ctx, task.ID, responseCode); err != nil {
+			return fmt.Errorf("error while marking delivery by repository: %w", err)
+		}
+
+		return nil
+	}
+
+	lastError := deliveryErr.Error()
+	if len(lastError) > maxWebhookErrorLength {
+		lastError = lastError[:maxWebhookErrorLength]
+	}
+
+	attempts := task.Attempts + 1
+	if attempts >= s.cfg.MaxAttempts {
+		if err := s.webhookDeliveryRepository.MarkFailed(ctx, task.ID, responseCode, lastError); err != nil {
+			return fmt.Errorf("error while marking delivery by repository: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := s.webhookDeliveryRepository.Reschedule(ctx,
+		task.ID, responseCode, lastError, s.backoff(attempts),
+	); err != nil {
+		return fmt.Errorf("error while rescheduling delivery by repository: %w", err)
+	}
+
+	return nil
+}
+
+// send posts payload of delivery to webhook. Address of webhook is checked again by dialer of client,
+// since host of webhook could be changed to resolve to internal address after its creation.
+func (s *WebhookImpl) send(ctx context.Context, task rdto.WebhookDeliveryTask) (int, error) {
+	if err := s.validateURL(ctx, task.URL); err != nil {
+		return 0, fmt.Errorf("webhook url isn't allowed: %w", err)
+	}
+
+	req, res := fasthttp.AcquireRequest(), fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(res)
+
+	req.SetRequestURI(task.URL)
+	req.Header.SetMethod(fasthttp.MethodPost)
+	req.Header.SetContentType("application/json")
+	req.Header.Set(webhookEventHeader, task.EventType)
+	req.Header.Set(webhookDeliveryHeader, task.EventID)
+	req.Header.Set(webhookSignatureHeader, signature.Sign([]byte(task.Secret), time.Now(), []byte(task.Payload)))
+	req.SetBodyString(task.Payload)
+
+	if err := s.client.DoTimeout(req, res, s.cfg.Timeout); err != nil {
+		return 0, fmt.Errorf("error while sending webhook: %w", err)
+	}
+
+	if res.StatusCode() < fasthttp.StatusOK || res.StatusCode() >= fasthttp.StatusMultipleChoices {
+		return res.StatusCode(), fmt.Errorf("webhook responded with status code %d", res.StatusCode())
+	}
+
+	return res.StatusCode(), nil
+}
+
+// backoff returns exponential delay before the next attempt: initial backoff doubled
+// for every made attempt and limited by max backoff.
+func (s *WebhookImpl) backoff(attempts int) time.Duration {
+	delay := s.cfg.InitialBackoff
+	for i := 1; i < attempts && delay < s.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > s.cfg.MaxBackoff {
+		return s.cfg.MaxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/internal/services"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+)
+
+// Periodic runs job every interval until context is canceled, every run of job is traced
+// as span of its own.
+type Periodic struct {
+	name     string
+	interval time.Duration
+	job      func(ctx context.Context) error
+	logger   *logging.Logger
+}
+
+func NewPeriodic(name string, interval time.Duration, job func(ctx context.Context) error) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		job:      job,
+		logger:   logging.NewLogger(name),
+	}
+}
+
+func (p *Periodic) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.run(ctx)
+		}
+	}
+}
+
+func (p *Periodic) run(ctx context.Context) {
+	ctx, span := tracing.Start(ctx, "worker."+p.name)
+	defer span.End()
+
+	if err := p.job(ctx); err != nil {
+		tracing.RecordError(span, err)
+		p.logger.WithContext(ctx).Error(err)
+	}
+}
+
+type Pool struct {
+	workers []*Periodic
+	wg      sync.WaitGroup
+}
+
+func NewPool(services *services.Service, tokens *authorization.JWT, cfg *config.Config) *Pool {
+	pool := &Pool{
+		workers: []*Periodic{
+			NewPeriodic("rating refresher", cfg.Notifications.RefreshInterval, services.Direction.RefreshRatings),
+			NewPeriodic("webhook dispatcher", cfg.Webhooks.DispatchInterval, func(ctx context.Context) error {
+				_, err := services.Webhook.DeliverPending(ctx)
+
+				return err
+			}),
+		},
+	}
+
+	if cfg.AuthTokens.Keys.Path != "" && cfg.AuthTokens.Keys.ReloadInterval > 0 {
+		pool.workers = append(
+			pool.workers, NewPeriodic(
+				"signing keys reloader", cfg.AuthTokens.Keys.ReloadInterval,
+				func(_ context.Context) error { return tokens.Reload() },
+			),
+		)
+	}
+
+	return pool
+}
+
+func (p *Pool) Run(ctx context.Context) {
+	for _, w := range p.workers {
+		p.wg.Add(1)
+
+		go func(w *Periodic) {
+			defer p.wg.Done()
+			w.Run(ctx)
+		}(w)
+	}
+}
+
+func (p *Pool) Wait() {
+	p.wg.Wait()
+}
@@ -1,21 +1,138 @@
 package apierrors
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Kind classifies errors, HTTP status of response is defined by kind of error.
+type Kind string
+
+const (
+	KindValidation          Kind = "validation"
+	KindUnauthorized        Kind = "unauthorized"
+	KindForbidden           Kind = "forbidden"
+	KindNotFound            Kind = "not_found"
+	KindConflict            Kind = "conflict"
+	KindLocked              Kind = "locked"
+	KindRateLimited         Kind = "rate_limited"
+	KindUpstreamUnavailable Kind = "upstream_unavailable"
+	KindInternal            Kind = "internal"
+)
+
+var kindStatuses = map[Kind]int{
+	KindValidation:          http.StatusBadRequest,
+	KindUnauthorized:        http.StatusUnauthorized,
+	KindForbidden:           http.StatusForbidden,
+	KindNotFound:            http.StatusNotFound,
+	KindConflict:            http.StatusConflict,
+	KindLocked:              http.StatusLocked,
+	KindRateLimited:         http.StatusTooManyRequests,
+	KindUpstreamUnavailable: http.StatusServiceUnavailable,
+	KindInternal:            http.StatusInternalServerError,
+}
+
+// Status returns HTTP status of errors of kind.
+func (k Kind) Status() int {
+	if status, ok := kindStatuses[k]; ok {
+		return status
+	}
 
-type APIError struct {
-	Message string `json:"message"`
+	return http.StatusInternalServerError
 }
 
-func NewAPIError(err error) *APIError {
-	return &APIError{Message: err.Error()}
+// Error is typed error with stable machine-readable code, e.g. direction_not_found. Errors with
+// the same code are equal for errors.Is, so predefined error may be wrapped around its cause.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  []FieldError
+	Err     error
 }
 
-func (e *APIError) Error() string {
+func NewError(kind Kind, code string, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
 	return e.Message
 }
 
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	var t *Error
+	if !errors.As(target, &t) {
+		return false
+	}
+
+	return e.Code == t.Code
+}
+
+// Wrap returns copy of error caused by err, cause is logged but isn't shown to clients.
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+
+	return &wrapped
+}
+
+// RetryError is returned when action is blocked for some time, RetryAfter is time
+// after which action can be retried.
+type RetryError struct {
+	Err        *Error
+	RetryAfter time.Duration
+}
+
+func NewRetryError(err *Error, retryAfter time.Duration) *RetryError {
+	return &RetryError{
+		Err:        err,
+		RetryAfter: retryAfter,
+	}
+}
+
+func (e *RetryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// From returns typed error of err chain. Errors which aren't typed are internal, so their
+// messages (e.g. of database failures) aren't shown to clients.
+func From(err error) *Error {
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed
+	}
+
+	return Internal.Wrap(err)
+}
+
 var (
-	InvalidRefreshToken        = NewAPIError(errors.New("invalid token"))
-	InvalidAuthorizationHeader = NewAPIError(errors.New("invalid authorization header"))
-	InvalidQueryIDParam        = NewAPIError(errors.New("invalid query id param"))
+	Internal                   = NewError(KindInternal, "internal", "internal server error")
+	InvalidRefreshToken        = NewError(KindUnauthorized, "invalid_refresh_token", "invalid token")
+	InvalidAuthorizationHeader = NewError(KindUnauthorized, "invalid_authorization_header", "invalid authorization header")
+	RevokedAccessToken         = NewError(KindUnauthorized, "revoked_access_token", "access token is revoked")
+	Unidentified               = NewError(KindUnauthorized, "unidentified", "user isn't identified")
+	InvalidQueryIDParam        = NewError(KindValidation, "invalid_id", "invalid query id param")
+	InsufficientRole           = NewError(KindForbidden, "insufficient_role", "insufficient role")
+	InsufficientScope          = NewError(
+		KindForbidden, "insufficient_scope", "personal access token doesn't have required scope",
+	)
+	PersonalTokenNotAllowed = NewError(
+		KindForbidden, "personal_token_not_allowed", "personal access tokens aren't accepted by this route",
+	)
+	PersonalTokenRateLimitExceeded = NewError(
+		KindRateLimited, "personal_token_rate_limit_exceeded", "personal access token rate limit exceeded",
+	)
 )
@@ -0,0 +1,37 @@
+package apierrors
+
+import "net/http"
+
+// ProblemContentType is content type of problem responses.
+const ProblemContentType = "application/problem+json"
+
+const blankProblemType = "about:blank"
+
+// Problem is RFC 7807 problem details. Type isn't resolvable, so title is HTTP status
+// text, clients should tell errors apart by code.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	TraceID   string       `json:"trace_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+func NewProblem(err *Error, instance string, requestID string) *Problem {
+	status := err.Kind.Status()
+
+	return &Problem{
+		Type:      blankProblemType,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    err.Message,
+		Instance:  instance,
+		Code:      err.Code,
+		RequestID: requestID,
+		Errors:    err.Fields,
+	}
+}
@@ -0,0 +1,48 @@
+package apierrors
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const invalidRequestCode = "invalid_request"
+
+// FieldError describes invalid field of request by failed validation rule and its parameter,
+// e.g. field username, rule min and param 4.
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+	Param string `json:"param,omitempty"`
+}
+
+// NewValidationError returns validation error of request which can't be bound or validated.
+// Failed validation rules are returned as fields of error.
+func NewValidationError(err error) *Error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return &Error{
+			Kind:    KindValidation,
+			Code:    invalidRequestCode,
+			Message: err.Error(),
+			Err:     err,
+		}
+	}
+
+	fields := make([]FieldError, len(validationErrors))
+	for i, e := range validationErrors {
+		fields[i] = FieldError{
+			Field: e.Field(),
+			Rule:  e.Tag(),
+			Param: e.Param(),
+		}
+	}
+
+	return &Error{
+		Kind:    KindValidation,
+		Code:    invalidRequestCode,
+		Message: "request validation failed",
+		Fields:  fields,
+		Err:     err,
+	}
+}
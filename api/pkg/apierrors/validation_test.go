@@ -0,0 +1,56 @@
+package apierrors_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/validation"
+)
+
+type credentials struct {
+	Username string `json:"username" validate:"required,min=4"`
+	Password string `json:"password" validate:"required"`
+}
+
+func TestApiErrors_NewValidationError(t *testing.T) {
+	t.Parallel()
+
+	validate := validation.NewValidator()
+
+	testCases := []struct {
+		name           string
+		err            error
+		expectedFields []apierrors.FieldError
+	}{
+		{
+			name: "failed validation rules",
+			err:  validate.Struct(credentials{Username: "abc"}),
+			expectedFields: []apierrors.FieldError{
+				{Field: "username", Rule: "min", Param: "4"},
+				{Field: "password", Rule: "required"},
+			},
+		},
+		{
+			name:           "malformed request",
+			err:            errors.New("unexpected EOF"),
+			expectedFields: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := apierrors.NewValidationError(tc.err)
+
+			assert.Equal(t, apierrors.KindValidation, err.Kind)
+			assert.Equal(t, "invalid_request", err.Code)
+			assert.Equal(t, tc.expectedFields, err.Fields)
+			assert.Equal(t, tc.err, errors.Unwrap(err))
+		})
+	}
+}
@@ -3,75 +3,234 @@ package authorization
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
 var ErrInvalidToken = errors.New("invalid token")
 
+// ErrSigningKeysNotSet is returned if neither asymmetric keys nor HS256 secrets of both
+// token types are configured.
+var ErrSigningKeysNotSet = errors.New("token signing keys aren't set")
+
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+)
+
+// Roles of users. Role is included in tokens, so it is changed for user on the next
+// tokens refresh.
+const (
+	RoleStudent   = "student"
+	RoleCounselor = "counselor"
+	RoleAdmin     = "admin"
+)
+
+const (
+	tokenIDLength = 16
+	envKeyID      = "env"
+)
+
 type JWTTokens struct {
-	AccessToken  string
-	RefreshToken string
+	AccessToken    string
+	AccessTokenID  string
+	RefreshToken   string
+	RefreshTokenID string
 }
 
+// TokenClaims are claims of access and refresh tokens. Every token has unique id (jti)
+// and belongs to session, which is the sign in on particular device.
 type TokenClaims struct {
 	jwt.StandardClaims
-	UserID uint
+	UserID    uint
+	SessionID string
+	Role      string
+	TokenType string
 }
 
-func ParseToken(token string, tokenCfg config.JWTToken) (*TokenClaims, error) {
-	parsedToken, err := jwt.ParseWithClaims(token, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
+// JWT signs and verifies access and refresh tokens. If asymmetric keys are configured,
+// both token types are signed by them and published by JWKS, otherwise tokens are
+// signed by HS256 secrets of ACCESS_TOKEN_SIGNING_KEY and REFRESH_TOKEN_SIGNING_KEY env.
+type JWT struct {
+	cfg         *config.AuthTokens
+	mu          sync.RWMutex
+	accessKeys  *KeySet
+	refreshKeys *KeySet
+}
 
-		return tokenCfg.SigningKey, nil
-	})
-	if err != nil {
-		return nil, ErrInvalidToken
+func NewJWT(cfg *config.AuthTokens) (*JWT, error) {
+	j := &JWT{cfg: cfg}
+	if err := j.Reload(); err != nil {
+		return nil, err
 	}
 
-	claims, ok := parsedToken.Claims.(*TokenClaims)
-	if !ok {
-		return nil, ErrInvalidToken
+	return j, nil
+}
+
+// Reload loads keys again, so keys added to or removed from keys directory are used
+// without restart.
+func (j *JWT) Reload() error {
+	accessKeys, refreshKeys, err := j.loadKeySets()
+	if err != nil {
+		return err
 	}
 
-	return claims, nil
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.accessKeys = accessKeys
+	j.refreshKeys = refreshKeys
+
+	return nil
 }
 
-func GenerateTokensFromPayload(userID uint, tokensConfig *config.AuthTokens) (*JWTTokens, error) {
-	accessToken, err := GenerateTokenFromPayload(userID, tokensConfig.AccessToken)
+func (j *JWT) GenerateTokens(userID uint, sessionID string, role string) (*JWTTokens, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	accessToken, accessTokenID, err := j.generateToken(
+		j.accessKeys, userID, sessionID, role, AccessTokenType, j.cfg.AccessToken.TTL,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := GenerateTokenFromPayload(userID, tokensConfig.RefreshToken)
+	refreshToken, refreshTokenID, err := j.generateToken(
+		j.refreshKeys, userID, sessionID, role, RefreshTokenType, j.cfg.RefreshToken.TTL,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &JWTTokens{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:    accessToken,
+		AccessTokenID:  accessTokenID,
+		RefreshToken:   refreshToken,
+		RefreshTokenID: refreshTokenID,
 	}, nil
 }
 
-func GenerateTokenFromPayload(userID uint, tokenCfg config.JWTToken) (string, error) {
-	tokenRaw := jwt.NewWithClaims(jwt.SigningMethodHS256, &TokenClaims{
+func (j *JWT) ParseAccessToken(token string) (*TokenClaims, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return j.parseToken(j.accessKeys, token, AccessTokenType)
+}
+
+func (j *JWT) ParseRefreshToken(token string) (*TokenClaims, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return j.parseToken(j.refreshKeys, token, RefreshTokenType)
+}
+
+func (j *JWT) JWKS() JWKS {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return j.accessKeys.JWKS()
+}
+
+func (j *JWT) generateToken(
+	keys *KeySet, userID uint, sessionID string, role string, tokenType string, ttl time.Duration,
+) (string, string, error) {
+	tokenID, err := random.Hex(tokenIDLength)
+	if err != nil {
+		return "", "", fmt.Errorf("error while generating token id: %w", err)
+	}
+
+	token, err := keys.sign(&TokenClaims{
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(tokenCfg.TTL).Unix(),
+			Id:        tokenID,
+			ExpiresAt: time.Now().Add(ttl).Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
+		Role:      role,
+		TokenType: tokenType,
 	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, tokenID, nil
+}
+
+func (j *JWT) parseToken(keys *KeySet, token string, tokenType string) (*TokenClaims, error) {
+	parsedToken, err := jwt.ParseWithClaims(token, &TokenClaims{}, keys.verifyingKey)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := parsedToken.Claims.(*TokenClaims)
+	if !ok || claims.TokenType != tokenType {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+func (j *JWT) loadKeySets() (*KeySet, *KeySet, error) {
+	keysCfg := j.cfg.Keys
+	if keysCfg.Path == "" && keysCfg.PrivateKey == "" {
+		if len(j.cfg.AccessToken.SigningKey) == 0 || len(j.cfg.RefreshToken.SigningKey) == 0 {
+			return nil, nil, ErrSigningKeysNotSet
+		}
+
+		accessKeys, err := NewKeySet("", NewHMACKey("", j.cfg.AccessToken.SigningKey))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		refreshKeys, err := NewKeySet("", NewHMACKey("", j.cfg.RefreshToken.SigningKey))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return accessKeys, refreshKeys, nil
+	}
+
+	var keys []*Key
+
+	if keysCfg.Path != "" {
+		dirKeys, err := LoadKeysDir(keysCfg.Path, keysCfg.PublishWindow)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keys = append(keys, dirKeys...)
+	}
+
+	signingKeyID := keysCfg.SigningKeyID
+
+	if keysCfg.PrivateKey != "" {
+		keyID := keysCfg.PrivateKeyID
+		if keyID == "" {
+			keyID = envKeyID
+		}
+
+		key, err := ParsePrivateKeyPEM(keyID, []byte(keysCfg.PrivateKey))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keys = append(keys, key)
+
+		if signingKeyID == "" {
+			signingKeyID = keyID
+		}
+	}
 
-	token, err := tokenRaw.SignedString(tokenCfg.SigningKey)
+	keySet, err := NewKeySet(signingKeyID, keys...)
 	if err != nil {
-		return "", fmt.Errorf("error while signing token: %w", err)
+		return nil, nil, err
 	}
 
-	return token, nil
+	return keySet, keySet, nil
 }
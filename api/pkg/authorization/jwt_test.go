@@ -1,7 +1,11 @@
 package authorization_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
@@ -14,7 +18,14 @@ import (
 func TestServices_JWTParseToken(t *testing.T) {
 	t.Parallel()
 
-	testTokens, err := authorization.GenerateTokensFromPayload(1, config.Get().AuthTokens)
+	tokens, err := authorization.NewJWT(hmacConfig())
+	if err != nil {
+		logrus.Fatalf("error occurred while creating jwt: %s", err.Error())
+
+		return
+	}
+
+	testTokens, err := tokens.GenerateTokens(1, "session", authorization.RoleStudent)
 	if err != nil {
 		logrus.Fatalf("error occurred while generating tokens: %s", err.Error())
 
@@ -29,34 +40,40 @@ func TestServices_JWTParseToken(t *testing.T) {
 	}
 
 	testCases := []struct {
-		name     string
-		token    string
-		tokenCfg config.JWTToken
-		err      error
+		name  string
+		token string
+		parse func(token string) (*authorization.TokenClaims, error)
+		err   error
 	}{
 		{
-			name:     "invalid token",
-			token:    "token:)",
-			tokenCfg: config.Get().AuthTokens.AccessToken,
-			err:      authorization.ErrInvalidToken,
+			name:  "invalid token",
+			token: "token:)",
+			parse: tokens.ParseAccessToken,
+			err:   authorization.ErrInvalidToken,
+		},
+		{
+			name:  "invalid token signing method",
+			token: tokenWithInvalidMethod,
+			parse: tokens.ParseRefreshToken,
+			err:   authorization.ErrInvalidToken,
 		},
 		{
-			name:     "invalid token signing method",
-			token:    tokenWithInvalidMethod,
-			tokenCfg: config.Get().AuthTokens.RefreshToken,
-			err:      authorization.ErrInvalidToken,
+			name:  "valid access token",
+			token: testTokens.AccessToken,
+			parse: tokens.ParseAccessToken,
+			err:   nil,
 		},
 		{
-			name:     "valid access token",
-			token:    testTokens.AccessToken,
-			tokenCfg: config.Get().AuthTokens.AccessToken,
-			err:      nil,
+			name:  "valid refresh token",
+			token: testTokens.RefreshToken,
+			parse: tokens.ParseRefreshToken,
+			err:   nil,
 		},
 		{
-			name:     "valid refresh token",
-			token:    testTokens.RefreshToken,
-			tokenCfg: config.Get().AuthTokens.RefreshToken,
-			err:      nil,
+			name:  "refresh token as access token",
+			token: testTokens.RefreshToken,
+			parse: tokens.ParseAccessToken,
+			err:   authorization.ErrInvalidToken,
 		},
 	}
 
@@ -65,8 +82,212 @@ func TestServices_JWTParseToken(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := authorization.ParseToken(tc.token, tc.tokenCfg)
+			_, err := tc.parse(tc.token)
 			assert.Equal(t, tc.err, err)
 		})
 	}
 }
+
+func TestServices_JWTTokenClaims(t *testing.T) {
+	t.Parallel()
+
+	tokens, err := authorization.NewJWT(hmacConfig())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	testTokens, err := tokens.GenerateTokens(1, "session", authorization.RoleAdmin)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	accessClaims, err := tokens.ParseAccessToken(testTokens.AccessToken)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	refreshClaims, err := tokens.ParseRefreshToken(testTokens.RefreshToken)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, uint(1), refreshClaims.UserID)
+	assert.Equal(t, "session", accessClaims.SessionID)
+	assert.Equal(t, "session", refreshClaims.SessionID)
+	assert.Equal(t, authorization.RoleAdmin, accessClaims.Role)
+	assert.Equal(t, testTokens.AccessTokenID, accessClaims.Id)
+	assert.Equal(t, testTokens.RefreshTokenID, refreshClaims.Id)
+	assert.NotEqual(t, accessClaims.Id, refreshClaims.Id)
+	assert.Empty(t, tokens.JWKS().Keys)
+}
+
+func TestServices_JWTKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		algorithm string
+	}{
+		{
+			name:      "RS256 keys",
+			algorithm: authorization.AlgorithmRS256,
+		},
+		{
+			name:      "EdDSA keys",
+			algorithm: authorization.AlgorithmEdDSA,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			writeKey(t, dir, "20260101000000-old", tc.algorithm)
+			publishKey(t, dir, "20260101000000-old")
+
+			cfg := *hmacConfig()
+			cfg.Keys = config.SigningKeys{Path: dir, PublishWindow: time.Hour}
+
+			tokens, err := authorization.NewJWT(&cfg)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			oldTokens, err := tokens.GenerateTokens(1, "session", authorization.RoleStudent)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			writeKey(t, dir, "20260201000000-new", tc.algorithm)
+
+			if !assert.NoError(t, tokens.Reload()) {
+				return
+			}
+
+			unpublishedTokens, err := tokens.GenerateTokens(1, "session", authorization.RoleStudent)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			publishKey(t, dir, "20260201000000-new")
+
+			if !assert.NoError(t, tokens.Reload()) {
+				return
+			}
+
+			newTokens, err := tokens.GenerateTokens(1, "session", authorization.RoleStudent)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, "20260101000000-old", keyID(t, oldTokens.AccessToken))
+			assert.Equal(t, "20260101000000-old", keyID(t, unpublishedTokens.AccessToken))
+			assert.Equal(t, "20260201000000-new", keyID(t, newTokens.AccessToken))
+
+			_, err = tokens.ParseAccessToken(oldTokens.AccessToken)
+			assert.NoError(t, err)
+
+			_, err = tokens.ParseRefreshToken(newTokens.RefreshToken)
+			assert.NoError(t, err)
+
+			jwks := tokens.JWKS()
+			if assert.Len(t, jwks.Keys, 2) {
+				assert.Equal(t, "20260101000000-old", jwks.Keys[0].KeyID)
+				assert.Equal(t, tc.algorithm, jwks.Keys[1].Algorithm)
+			}
+
+			hmacTokens, err := authorization.NewJWT(hmacConfig())
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			_, err = hmacTokens.ParseAccessToken(newTokens.AccessToken)
+			assert.Equal(t, authorization.ErrInvalidToken, err)
+		})
+	}
+}
+
+func TestServices_JWTSigningKeysNotSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		accessToken  string
+		refreshToken string
+	}{
+		{
+			name: "no secrets",
+		},
+		{
+			name:        "no refresh token secret",
+			accessToken: "access-secret",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := *config.Get().AuthTokens
+			cfg.AccessToken.SigningKey = []byte(tc.accessToken)
+			cfg.RefreshToken.SigningKey = []byte(tc.refreshToken)
+			cfg.Keys = config.SigningKeys{}
+
+			_, err := authorization.NewJWT(&cfg)
+			assert.Equal(t, authorization.ErrSigningKeysNotSet, err)
+		})
+	}
+}
+
+// hmacConfig returns config of tokens signed by HS256 secrets, which aren't set in .env of tests.
+func hmacConfig() *config.AuthTokens {
+	cfg := *config.Get().AuthTokens
+	cfg.AccessToken.SigningKey = []byte("access-secret")
+	cfg.RefreshToken.SigningKey = []byte("refresh-secret")
+
+	return &cfg
+}
+
+func writeKey(t *testing.T, dir string, id string, algorithm string) {
+	t.Helper()
+
+	key, err := authorization.GenerateKey(id, algorithm, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := authorization.MarshalPrivateKeyPEM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, id+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// publishKey makes key file look as added before publish window.
+func publishKey(t *testing.T, dir string, id string) {
+	t.Helper()
+
+	modified := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, id+".pem"), modified, modified); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func keyID(t *testing.T, token string) string {
+	t.Helper()
+
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token, &authorization.TokenClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kid, _ := parsed.Header["kid"].(string)
+
+	return kid
+}
@@ -0,0 +1,244 @@
+package authorization
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	keyFileExtension = ".pem"
+	privateKeyType   = "PRIVATE KEY"
+	kidHeader        = "kid"
+)
+
+var (
+	ErrUnsupportedKey       = errors.New("unsupported key")
+	ErrUnsupportedAlgorithm = errors.New("unsupported algorithm")
+	ErrSigningKeyNotFound   = errors.New("signing key not found")
+)
+
+// Key is the key which signs and verifies tokens, it is identified by id put to
+// kid header of token. Key may sign tokens only after NotBefore, before that it is
+// only published, so verifiers get it before tokens signed by it.
+type Key struct {
+	ID        string
+	Algorithm string
+	NotBefore time.Time
+	signing   interface{}
+	verifying interface{}
+}
+
+func NewHMACKey(id string, secret []byte) *Key {
+	return &Key{
+		ID:        id,
+		Algorithm: AlgorithmHS256,
+		signing:   secret,
+		verifying: secret,
+	}
+}
+
+// GenerateKey generates new asymmetric key, bits are used only by RS256.
+func GenerateKey(id string, algorithm string, bits int) (*Key, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		privateKey, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, fmt.Errorf("error while generating rsa key: %w", err)
+		}
+
+		return newKey(id, privateKey)
+	case AlgorithmEdDSA:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("error while generating ed25519 key: %w", err)
+		}
+
+		return newKey(id, privateKey)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// ParsePrivateKeyPEM parses PKCS #8 or PKCS #1 encoded RSA or Ed25519 private key.
+func ParsePrivateKeyPEM(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("error while decoding pem of key %s", id)
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return newKey(id, privateKey)
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing private key %s: %w", id, err)
+	}
+
+	return newKey(id, privateKey)
+}
+
+func MarshalPrivateKeyPEM(key *Key) ([]byte, error) {
+	if key.Algorithm == AlgorithmHS256 {
+		return nil, ErrUnsupportedKey
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key.signing)
+	if err != nil {
+		return nil, fmt.Errorf("error while marshaling private key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: privateKeyType, Bytes: der}), nil
+}
+
+// LoadKeysDir loads private keys from .pem files of directory, file name is the key id.
+// Keys may sign only after publishWindow passed since their files were modified.
+func LoadKeysDir(dir string, publishWindow time.Duration) ([]*Key, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading keys directory: %w", err)
+	}
+
+	keys := make([]*Key, 0, len(files))
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != keyFileExtension {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error while reading key file: %w", err)
+		}
+
+		key, err := ParsePrivateKeyPEM(strings.TrimSuffix(file.Name(), keyFileExtension), data)
+		if err != nil {
+			return nil, err
+		}
+
+		key.NotBefore = file.ModTime().Add(publishWindow)
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func newKey(id string, privateKey interface{}) (*Key, error) {
+	switch k := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return &Key{ID: id, Algorithm: AlgorithmRS256, signing: k, verifying: &k.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: id, Algorithm: AlgorithmEdDSA, signing: k, verifying: k.Public()}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+func (k *Key) signingMethod() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// KeySet is the set of keys verifying tokens, one of them signs new tokens. Keys which
+// don't sign anymore stay in set until tokens signed by them expire.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// NewKeySet returns set of keys signing by key with given id. If id is empty, the key
+// with greatest id of keys past their NotBefore signs, so keys named by their creation
+// time are rotated by adding. If no key is past NotBefore yet (e.g. on the first start),
+// the key which gets past it first signs.
+func NewKeySet(signingKeyID string, keys ...*Key) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		set.keys[key.ID] = key
+	}
+
+	if signingKeyID == "" {
+		signingKeyID = activeKeyID(keys, time.Now())
+	}
+
+	signing, ok := set.keys[signingKeyID]
+	if !ok {
+		return nil, ErrSigningKeyNotFound
+	}
+
+	set.signing = signing
+
+	return set, nil
+}
+
+// activeKeyID returns id of key which signs by default, see NewKeySet.
+func activeKeyID(keys []*Key, now time.Time) string {
+	sorted := make([]*Key, len(keys))
+	copy(sorted, keys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID > sorted[j].ID
+	})
+
+	var earliest *Key
+
+	for _, key := range sorted {
+		if !key.NotBefore.After(now) {
+			return key.ID
+		}
+
+		if earliest == nil || key.NotBefore.Before(earliest.NotBefore) {
+			earliest = key
+		}
+	}
+
+	if earliest == nil {
+		return ""
+	}
+
+	return earliest.ID
+}
+
+func (s *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.signing.signingMethod(), claims)
+	if s.signing.ID != "" {
+		token.Header[kidHeader] = s.signing.ID
+	}
+
+	signed, err := token.SignedString(s.signing.signing)
+	if err != nil {
+		return "", fmt.Errorf("error while signing token: %w", err)
+	}
+
+	return signed, nil
+}
+
+func (s *KeySet) verifyingKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header[kidHeader].(string)
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, errors.New("invalid signing method")
+	}
+
+	return key.verifying, nil
+}
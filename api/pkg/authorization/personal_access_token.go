@@ -0,0 +1,72 @@
+package authorization
+
+import (
+	"errors"
+	"strings"
+)
+
+// PersonalAccessTokenPrefix distinguishes personal access tokens from jwt access tokens
+// passed by the same authorization header.
+const PersonalAccessTokenPrefix = "rlmp_pat_"
+
+// Scopes of personal access tokens.
+const (
+	ScopeReadProfile       = "read:profile"
+	ScopeReadUniversities  = "read:universities"
+	ScopeWriteUniversities = "write:universities"
+	ScopeReadDirections    = "read:directions"
+	ScopeWriteDirections   = "write:directions"
+	ScopeReadRatings       = "read:ratings"
+	ScopeReadAlertRules    = "read:alert_rules"
+	ScopeWriteAlertRules   = "write:alert_rules"
+	ScopeReadWebhooks      = "read:webhooks"
+	ScopeWriteWebhooks     = "write:webhooks"
+)
+
+// Scopes are all scopes personal access token can be granted.
+var Scopes = []string{
+	ScopeReadProfile,
+	ScopeReadUniversities,
+	ScopeWriteUniversities,
+	ScopeReadDirections,
+	ScopeWriteDirections,
+	ScopeReadRatings,
+	ScopeReadAlertRules,
+	ScopeWriteAlertRules,
+	ScopeReadWebhooks,
+	ScopeWriteWebhooks,
+}
+
+var ErrPersonalAccessTokenRateLimitExceeded = errors.New("personal access token rate limit exceeded")
+
+// PersonalAccessTokenClaims are user and scopes of authenticated personal access token.
+type PersonalAccessTokenClaims struct {
+	TokenID uint
+	UserID  uint
+	Role    string
+	Scopes  []string
+}
+
+func (c *PersonalAccessTokenClaims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+func IsScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
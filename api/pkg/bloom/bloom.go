@@ -0,0 +1,82 @@
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+	"sync"
+)
+
+const (
+	minBits   = 64
+	wordSize  = 64
+	hashShift = 32
+)
+
+// Filter is a concurrency safe bloom filter of strings. Test never returns false
+// for added value, but may return true for not added one with configured probability.
+type Filter struct {
+	mu     sync.RWMutex
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+// New returns filter sized to keep false positive rate for the given number of values.
+func New(capacity uint, falsePositiveRate float64) *Filter {
+	if capacity == 0 {
+		capacity = 1
+	}
+
+	size := uint64(math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	if size < minBits {
+		size = minBits
+	}
+
+	hashes := uint64(math.Round(float64(size) / float64(capacity) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+
+	return &Filter{
+		bits:   make([]uint64, (size+wordSize-1)/wordSize),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+func (f *Filter) Add(value string) {
+	h1, h2 := f.hash(value)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := uint64(0); i < f.hashes; i++ {
+		position := (h1 + i*h2) % f.size
+		f.bits[position/wordSize] |= 1 << (position % wordSize)
+	}
+}
+
+func (f *Filter) Test(value string) bool {
+	h1, h2 := f.hash(value)
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for i := uint64(0); i < f.hashes; i++ {
+		position := (h1 + i*h2) % f.size
+		if f.bits[position/wordSize]&(1<<(position%wordSize)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hash returns two hashes of value, positions of value bits are their linear combinations.
+func (f *Filter) hash(value string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(value))
+	sum := h.Sum64()
+
+	return sum & math.MaxUint32, sum>>hashShift | 1
+}
@@ -0,0 +1,65 @@
+package bloom_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/bloom"
+)
+
+func TestBloom_Filter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		capacity          uint
+		falsePositiveRate float64
+	}{
+		{
+			name:              "small filter",
+			capacity:          10,
+			falsePositiveRate: 0.1,
+		},
+		{
+			name:              "large filter",
+			capacity:          10000,
+			falsePositiveRate: 0.01,
+		},
+		{
+			name:              "zero capacity",
+			capacity:          0,
+			falsePositiveRate: 0.01,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := bloom.New(tc.capacity, tc.falsePositiveRate)
+
+			for i := uint(0); i < tc.capacity; i++ {
+				filter.Add(fmt.Sprintf("added %d", i))
+			}
+
+			for i := uint(0); i < tc.capacity; i++ {
+				assert.True(t, filter.Test(fmt.Sprintf("added %d", i)))
+			}
+
+			const checks = 10000
+
+			falsePositives := 0
+
+			for i := 0; i < checks; i++ {
+				if filter.Test(fmt.Sprintf("not added %d", i)) {
+					falsePositives++
+				}
+			}
+
+			assert.LessOrEqual(t, float64(falsePositives)/checks, 2*tc.falsePositiveRate)
+		})
+	}
+}
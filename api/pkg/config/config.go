@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,20 +19,52 @@ const (
 )
 
 type Config struct {
-	Server     *Server
-	DB         *DB
-	Cache      *Cache
-	AuthTokens *AuthTokens
-	Parsing    *Parsing
+	Server        *Server
+	DB            *DB
+	Cache         *Cache
+	AuthTokens    *AuthTokens
+	Revocation    *Revocation
+	SignIn        *SignIn
+	PAT           *PersonalAccessTokens
+	TwoFactor     *TwoFactor
+	PasswordReset *PasswordReset
+	OIDC          *OIDC
+	Encryption    *Encryption
+	Parsing       *Parsing
+	Notifications *Notifications
+	Webhooks      *Webhooks
+	Email         *Email
+	Telegram      *Telegram
+	LiveUpdates   *LiveUpdates
+	GraphQL       *GraphQL
+	GRPC          *GRPC
+	Logging       *Logging
+	Tracing       *Tracing
 }
 
 func newConfig() *Config {
 	return &Config{
-		Server:     newServer(),
-		DB:         newDB(),
-		Cache:      newCache(),
-		AuthTokens: newAuthTokens(),
-		Parsing:    newParsing(),
+		Server:        newServer(),
+		DB:            newDB(),
+		Cache:         newCache(),
+		AuthTokens:    newAuthTokens(),
+		Revocation:    newRevocation(),
+		SignIn:        newSignIn(),
+		PAT:           newPersonalAccessTokens(),
+		TwoFactor:     newTwoFactor(),
+		PasswordReset: newPasswordReset(),
+		OIDC:          newOIDC(),
+		Encryption:    newEncryption(),
+		Parsing:       newParsing(),
+		Notifications: newNotifications(),
+		Webhooks:      newWebhooks(),
+		Email:         newEmail(),
+		Telegram:      newTelegram(),
+		LiveUpdates:   newLiveUpdates(),
+		GraphQL:       newGraphQL(),
+		GRPC:          newGRPC(),
+		Logging:       newLogging(),
+		Tracing:       newTracing(),
 	}
 }
 
@@ -95,6 +128,7 @@ type Server struct {
 	MaxHeaderBytes int
 	ReadTimeout    time.Duration
 	WriteTimeout   time.Duration
+	TrustedProxies []string
 }
 
 func newServer() *Server {
@@ -103,6 +137,7 @@ func newServer() *Server {
 		MaxHeaderBytes: viper.GetInt("server.max_header_bytes"),
 		ReadTimeout:    viper.GetDuration("server.read_timeout"),
 		WriteTimeout:   viper.GetDuration("server.write_timeout"),
+		TrustedProxies: viper.GetStringSlice("server.trusted_proxies"),
 	}
 }
 
@@ -149,24 +184,204 @@ type JWTToken struct {
 	SigningKey []byte
 }
 
+// SigningKeys are asymmetric keys signing tokens instead of HS256 secrets. Keys are
+// loaded from .pem files of Path named by key id and from AUTH_SIGNING_KEY env. Keys
+// added to Path are published in JWKS for PublishWindow before they start signing.
+type SigningKeys struct {
+	Path           string
+	SigningKeyID   string
+	ReloadInterval time.Duration
+	PublishWindow  time.Duration
+	PrivateKey     string
+	PrivateKeyID   string
+}
+
 type AuthTokens struct {
 	AccessToken  JWTToken
 	RefreshToken JWTToken
+	Keys         SigningKeys
 }
 
 func newAuthTokens() *AuthTokens {
 	return &AuthTokens{
 		AccessToken: JWTToken{
 			TTL:        viper.GetDuration("auth.access_token.ttl"),
-			SigningKey: []byte(viper.GetString("auth.access_token.signing_key")),
+			SigningKey: []byte(os.Getenv("ACCESS_TOKEN_SIGNING_KEY")),
 		},
 		RefreshToken: JWTToken{
 			TTL:        viper.GetDuration("auth.refresh_token.ttl"),
-			SigningKey: []byte(viper.GetString("auth.refresh_token.signing_key")),
+			SigningKey: []byte(os.Getenv("REFRESH_TOKEN_SIGNING_KEY")),
+		},
+		Keys: SigningKeys{
+			Path:           viper.GetString("auth.keys.path"),
+			SigningKeyID:   viper.GetString("auth.keys.signing_key_id"),
+			ReloadInterval: viper.GetDuration("auth.keys.reload_interval"),
+			PublishWindow:  viper.GetDuration("auth.keys.publish_window"),
+			PrivateKey:     os.Getenv("AUTH_SIGNING_KEY"),
+			PrivateKeyID:   os.Getenv("AUTH_SIGNING_KEY_ID"),
 		},
 	}
 }
 
+type Revocation struct {
+	BloomFilterEnabled           bool
+	BloomFilterCapacity          uint
+	BloomFilterFalsePositiveRate float64
+	BloomFilterRebuildInterval   time.Duration
+}
+
+func newRevocation() *Revocation {
+	return &Revocation{
+		BloomFilterEnabled:           viper.GetBool("auth.revocation.bloom_filter.enabled"),
+		BloomFilterCapacity:          viper.GetUint("auth.revocation.bloom_filter.capacity"),
+		BloomFilterFalsePositiveRate: viper.GetFloat64("auth.revocation.bloom_filter.false_positive_rate"),
+		BloomFilterRebuildInterval:   viper.GetDuration("auth.revocation.bloom_filter.rebuild_interval"),
+	}
+}
+
+// SignIn limits sign in attempts. Attempts from ip are limited in sliding window. Failed
+// attempts of username are counted in sliding window: after DelayAfter failures the next
+// attempt is delayed, delay is doubled on every failure up to MaxDelay, and after
+// LockoutThreshold failures username is locked for LockoutDuration.
+type SignIn struct {
+	IPLimit          int
+	IPWindow         time.Duration
+	FailureWindow    time.Duration
+	DelayAfter       int
+	InitialDelay     time.Duration
+	MaxDelay         time.Duration
+	LockoutThreshold int
+	LockoutDuration  time.Duration
+}
+
+func newSignIn() *SignIn {
+	return &SignIn{
+		IPLimit:          viper.GetInt("auth.sign_in.ip_limit"),
+		IPWindow:         viper.GetDuration("auth.sign_in.ip_window"),
+		FailureWindow:    viper.GetDuration("auth.sign_in.failure_window"),
+		DelayAfter:       viper.GetInt("auth.sign_in.delay_after"),
+		InitialDelay:     viper.GetDuration("auth.sign_in.initial_delay"),
+		MaxDelay:         viper.GetDuration("auth.sign_in.max_delay"),
+		LockoutThreshold: viper.GetInt("auth.sign_in.lockout_threshold"),
+		LockoutDuration:  viper.GetDuration("auth.sign_in.lockout_duration"),
+	}
+}
+
+// PersonalAccessTokens limits personal access tokens. Rate limit is count of requests
+// of token per RateLimitWindow, token last usage time is updated once per LastUsedInterval.
+type PersonalAccessTokens struct {
+	MaxPerUser       int
+	DefaultRateLimit int
+	MaxRateLimit     int
+	RateLimitWindow  time.Duration
+	LastUsedInterval time.Duration
+}
+
+func newPersonalAccessTokens() *PersonalAccessTokens {
+	return &PersonalAccessTokens{
+		MaxPerUser:       viper.GetInt("auth.personal_access_tokens.max_per_user"),
+		DefaultRateLimit: viper.GetInt("auth.personal_access_tokens.default_rate_limit"),
+		MaxRateLimit:     viper.GetInt("auth.personal_access_tokens.max_rate_limit"),
+		RateLimitWindow:  viper.GetDuration("auth.personal_access_tokens.rate_limit_window"),
+		LastUsedInterval: viper.GetDuration("auth.personal_access_tokens.last_used_interval"),
+	}
+}
+
+// TwoFactor configures TOTP two-factor authentication. Codes of Skew neighbouring periods are
+// accepted too. Challenge token of the first sign in step lives ChallengeTTL and accepts
+// ChallengeAttempts codes.
+type TwoFactor struct {
+	Issuer            string
+	Skew              int
+	ChallengeTTL      time.Duration
+	ChallengeAttempts int
+	BackupCodes       int
+}
+
+func newTwoFactor() *TwoFactor {
+	return &TwoFactor{
+		Issuer:            viper.GetString("auth.two_factor.issuer"),
+		Skew:              viper.GetInt("auth.two_factor.skew"),
+		ChallengeTTL:      viper.GetDuration("auth.two_factor.challenge_ttl"),
+		ChallengeAttempts: viper.GetInt("auth.two_factor.challenge_attempts"),
+		BackupCodes:       viper.GetInt("auth.two_factor.backup_codes"),
+	}
+}
+
+type PasswordReset struct {
+	TokenTTL        time.Duration
+	URL             string
+	RateLimit       int
+	RateLimitWindow time.Duration
+}
+
+func newPasswordReset() *PasswordReset {
+	return &PasswordReset{
+		TokenTTL:        viper.GetDuration("password_reset.token_ttl"),
+		URL:             viper.GetString("password_reset.url"),
+		RateLimit:       viper.GetInt("password_reset.rate_limit"),
+		RateLimitWindow: viper.GetDuration("password_reset.rate_limit_window"),
+	}
+}
+
+// OIDCProvider is OpenID Connect provider, client secret is read from
+// OIDC_<NAME>_CLIENT_SECRET env and may be empty for public clients.
+type OIDCProvider struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"-"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+type OIDC struct {
+	StateTTL      time.Duration
+	AutoProvision bool
+	Providers     []OIDCProvider
+}
+
+func newOIDC() *OIDC {
+	var providers []OIDCProvider
+	if err := viper.UnmarshalKey("oidc.providers", &providers); err != nil {
+		logrus.Fatalf("error while reading oidc providers config: %s", err)
+	}
+
+	for i := range providers {
+		providers[i].ClientSecret = os.Getenv(
+			fmt.Sprintf("OIDC_%s_CLIENT_SECRET", strings.ToUpper(providers[i].Name)),
+		)
+	}
+
+	return &OIDC{
+		StateTTL:      viper.GetDuration("oidc.state_ttl"),
+		AutoProvision: viper.GetBool("oidc.auto_provision"),
+		Providers:     providers,
+	}
+}
+
+// Encryption keys are read from env. SNILS_ENCRYPTION_KEYS holds comma separated
+// "<version>:<base64 key>" pairs, SNILS_ENCRYPTION_KEY_VERSION selects the key encrypting
+// new values and defaults to the latest one, SNILS_INDEX_KEY is base64 key of blind index.
+// TOTP_ENCRYPTION_KEYS and TOTP_ENCRYPTION_KEY_VERSION encrypt TOTP secrets the same way.
+type Encryption struct {
+	SnilsKeys       string
+	SnilsKeyVersion string
+	SnilsIndexKey   string
+	TOTPKeys        string
+	TOTPKeyVersion  string
+}
+
+func newEncryption() *Encryption {
+	return &Encryption{
+		SnilsKeys:       os.Getenv("SNILS_ENCRYPTION_KEYS"),
+		SnilsKeyVersion: os.Getenv("SNILS_ENCRYPTION_KEY_VERSION"),
+		SnilsIndexKey:   os.Getenv("SNILS_INDEX_KEY"),
+		TOTPKeys:        os.Getenv("TOTP_ENCRYPTION_KEYS"),
+		TOTPKeyVersion:  os.Getenv("TOTP_ENCRYPTION_KEY_VERSION"),
+	}
+}
+
 type Parsing struct {
 	RatingListTTL       time.Duration
 	ReadTimeout         time.Duration
@@ -184,3 +399,165 @@ func newParsing() *Parsing {
 		MaxConnsPerHost:     viper.GetInt("parsing.max_conns_per_host"),
 	}
 }
+
+type Notifications struct {
+	RefreshInterval time.Duration
+}
+
+func newNotifications() *Notifications {
+	return &Notifications{
+		RefreshInterval: viper.GetDuration("notifications.refresh_interval"),
+	}
+}
+
+type Webhooks struct {
+	DispatchInterval time.Duration
+	BatchSize        int
+	Timeout          time.Duration
+	MaxAttempts      int
+	InitialBackoff   time.Duration
+	MaxBackoff       time.Duration
+	DeliveriesLimit  int
+}
+
+func newWebhooks() *Webhooks {
+	return &Webhooks{
+		DispatchInterval: viper.GetDuration("webhooks.dispatch_interval"),
+		BatchSize:        viper.GetInt("webhooks.batch_size"),
+		Timeout:          viper.GetDuration("webhooks.timeout"),
+		MaxAttempts:      viper.GetInt("webhooks.max_attempts"),
+		InitialBackoff:   viper.GetDuration("webhooks.initial_backoff"),
+		MaxBackoff:       viper.GetDuration("webhooks.max_backoff"),
+		DeliveriesLimit:  viper.GetInt("webhooks.deliveries_limit"),
+	}
+}
+
+type Email struct {
+	SMTPHost        string
+	SMTPPort        string
+	SMTPUsername    string
+	SMTPPassword    string
+	From            string
+	TemplatesPath   string
+	DefaultLanguage string
+	PublicURL       string
+	LinksSecret     []byte
+	VerificationTTL time.Duration
+	RateLimit       int
+	RateLimitWindow time.Duration
+}
+
+func newEmail() *Email {
+	return &Email{
+		SMTPHost:        viper.GetString("email.smtp_host"),
+		SMTPPort:        viper.GetString("email.smtp_port"),
+		SMTPUsername:    viper.GetString("email.smtp_username"),
+		SMTPPassword:    os.Getenv("SMTP_PASSWORD"),
+		From:            viper.GetString("email.from"),
+		TemplatesPath:   viper.GetString("email.templates_path"),
+		DefaultLanguage: viper.GetString("email.default_language"),
+		PublicURL:       viper.GetString("email.public_url"),
+		LinksSecret:     []byte(os.Getenv("EMAIL_LINKS_SECRET")),
+		VerificationTTL: viper.GetDuration("email.verification_ttl"),
+		RateLimit:       viper.GetInt("email.rate_limit"),
+		RateLimitWindow: viper.GetDuration("email.rate_limit_window"),
+	}
+}
+
+type Telegram struct {
+	APIURL         string
+	BotToken       string
+	BotUsername    string
+	PollingTimeout time.Duration
+	LinkCodeTTL    time.Duration
+}
+
+func newTelegram() *Telegram {
+	return &Telegram{
+		APIURL:         viper.GetString("telegram.api_url"),
+		BotToken:       os.Getenv("TELEGRAM_BOT_TOKEN"),
+		BotUsername:    viper.GetString("telegram.bot_username"),
+		PollingTimeout: viper.GetDuration("telegram.polling_timeout"),
+		LinkCodeTTL:    viper.GetDuration("telegram.link_code_ttl"),
+	}
+}
+
+type LiveUpdates struct {
+	HeartbeatInterval time.Duration
+	WriteTimeout      time.Duration
+	LatestTTL         time.Duration
+}
+
+func newLiveUpdates() *LiveUpdates {
+	return &LiveUpdates{
+		HeartbeatInterval: viper.GetDuration("live_updates.heartbeat_interval"),
+		WriteTimeout:      viper.GetDuration("live_updates.write_timeout"),
+		LatestTTL:         viper.GetDuration("live_updates.latest_ttl"),
+	}
+}
+
+// GraphQL limits operations before execution. Fields of lists are counted in complexity
+// as many times as list size, which is taken from first or limit argument or assumed
+// to be default list size.
+type GraphQL struct {
+	MaxDepth        int
+	MaxComplexity   int
+	DefaultListSize int
+}
+
+func newGraphQL() *GraphQL {
+	return &GraphQL{
+		MaxDepth:        viper.GetInt("graphql.max_depth"),
+		MaxComplexity:   viper.GetInt("graphql.max_complexity"),
+		DefaultListSize: viper.GetInt("graphql.default_list_size"),
+	}
+}
+
+// GRPC configures server for internal consumers, e.g. bot and worker processes.
+// Reflection lets tools like grpcurl discover services without proto files.
+type GRPC struct {
+	Port       string
+	Reflection bool
+}
+
+func newGRPC() *GRPC {
+	return &GRPC{
+		Port:       viper.GetString("grpc.port"),
+		Reflection: viper.GetBool("grpc.reflection"),
+	}
+}
+
+// Logging configures level (trace, debug, info, warn, error) and format (text or json) of logs.
+type Logging struct {
+	Level  string
+	Format string
+}
+
+func newLogging() *Logging {
+	return &Logging{
+		Level:  viper.GetString("logging.level"),
+		Format: viper.GetString("logging.format"),
+	}
+}
+
+// Tracing configures export of OpenTelemetry spans by OTLP gRPC exporter to endpoint of
+// collector or by stdout exporter. Sample ratio is applied to traces started by api.
+type Tracing struct {
+	Enabled     bool
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	ServiceName string
+	SampleRatio float64
+}
+
+func newTracing() *Tracing {
+	return &Tracing{
+		Enabled:     viper.GetBool("tracing.enabled"),
+		Exporter:    viper.GetString("tracing.exporter"),
+		Endpoint:    viper.GetString("tracing.endpoint"),
+		Insecure:    viper.GetBool("tracing.insecure"),
+		ServiceName: viper.GetString("tracing.service_name"),
+		SampleRatio: viper.GetFloat64("tracing.sample_ratio"),
+	}
+}
@@ -0,0 +1,77 @@
+package dataloader
+
+import "sync"
+
+// BatchFunc loads values by keys at once. Keys without values are loaded as nil.
+type BatchFunc func(keys []uint) (map[uint]interface{}, error)
+
+type result struct {
+	value interface{}
+	err   error
+}
+
+// Loader batches loading of values requested one by one and caches them, so every key
+// is loaded once. Load doesn't load value, it returns thunk, which loads values of all
+// pending keys on the first call. It suits executors resolving fields level by level, which call
+// thunks of the level only after all its fields are resolved.
+type Loader struct {
+	mu      sync.Mutex
+	batch   BatchFunc
+	pending []uint
+	results map[uint]*result
+}
+
+func New(batch BatchFunc) *Loader {
+	return &Loader{
+		batch:   batch,
+		results: make(map[uint]*result),
+	}
+}
+
+func (l *Loader) Load(key uint) func() (interface{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.results[key]; !ok && !l.isPending(key) {
+		l.pending = append(l.pending, key)
+	}
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if _, ok := l.results[key]; !ok {
+			l.flush()
+		}
+
+		r := l.results[key]
+
+		return r.value, r.err
+	}
+}
+
+func (l *Loader) isPending(key uint) bool {
+	for _, k := range l.pending {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *Loader) flush() {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.batch(keys)
+	for _, key := range keys {
+		if err != nil {
+			l.results[key] = &result{err: err}
+
+			continue
+		}
+
+		l.results[key] = &result{value: values[key]}
+	}
+}
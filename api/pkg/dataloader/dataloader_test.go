@@ -0,0 +1,109 @@
+package dataloader_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/dataloader"
+)
+
+func TestLoader_Load(t *testing.T) {
+	t.Parallel()
+
+	errBatch := errors.New("batch error")
+
+	testCases := []struct {
+		name            string
+		keys            []uint
+		batchErr        error
+		expectedBatches [][]uint
+		expectedValues  []interface{}
+		expectedErr     error
+	}{
+		{
+			name:            "keys are loaded by one batch",
+			keys:            []uint{1, 2, 3},
+			expectedBatches: [][]uint{{1, 2, 3}},
+			expectedValues:  []interface{}{"1", "2", "3"},
+		},
+		{
+			name:            "repeated keys are loaded once",
+			keys:            []uint{2, 1, 2},
+			expectedBatches: [][]uint{{2, 1}},
+			expectedValues:  []interface{}{"2", "1", "2"},
+		},
+		{
+			name:            "missing keys are loaded as nil",
+			keys:            []uint{1, 5},
+			expectedBatches: [][]uint{{1, 5}},
+			expectedValues:  []interface{}{"1", nil},
+		},
+		{
+			name:            "batch error is returned for every key",
+			keys:            []uint{1, 2},
+			batchErr:        errBatch,
+			expectedBatches: [][]uint{{1, 2}},
+			expectedValues:  []interface{}{nil, nil},
+			expectedErr:     errBatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			batches := make([][]uint, 0)
+			loader := dataloader.New(func(keys []uint) (map[uint]interface{}, error) {
+				batches = append(batches, keys)
+				if tc.batchErr != nil {
+					return nil, tc.batchErr
+				}
+
+				values := make(map[uint]interface{})
+				for _, k := range keys {
+					if k < 4 {
+						values[k] = string(rune('0' + k))
+					}
+				}
+
+				return values, nil
+			})
+
+			thunks := make([]func() (interface{}, error), len(tc.keys))
+			for i, k := range tc.keys {
+				thunks[i] = loader.Load(k)
+			}
+
+			for i, thunk := range thunks {
+				value, err := thunk()
+				assert.Equal(t, tc.expectedValues[i], value)
+				assert.Equal(t, tc.expectedErr, err)
+			}
+
+			assert.Equal(t, tc.expectedBatches, batches)
+		})
+	}
+}
+
+func TestLoader_LoadCached(t *testing.T) {
+	t.Parallel()
+
+	batches := 0
+	loader := dataloader.New(func(keys []uint) (map[uint]interface{}, error) {
+		batches++
+
+		return map[uint]interface{}{1: "1"}, nil
+	})
+
+	value, err := loader.Load(1)()
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+
+	value, err = loader.Load(1)()
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+	assert.Equal(t, 1, batches)
+}
@@ -0,0 +1,246 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// KeySize is size of AES-256 keys and minimal size of blind index keys.
+	KeySize = 32
+
+	versionPrefix    = "v"
+	versionSeparator = ":"
+	keysSeparator    = ","
+	partsCount       = 2
+)
+
+var (
+	ErrKeysNotSet         = errors.New("encryption keys aren't set")
+	ErrInvalidKey         = errors.New("invalid encryption key")
+	ErrUnknownKeyVersion  = errors.New("unknown encryption key version")
+	ErrInvalidCiphertext  = errors.New("invalid ciphertext")
+	ErrDecryptionFailed   = errors.New("decryption failed")
+	ErrInvalidKeysFormat  = errors.New("invalid encryption keys format, expected <version>:<base64 key>,...")
+	ErrShortBlindIndexKey = errors.New("blind index key must be at least 32 bytes")
+)
+
+// Cipher encrypts values with AES-256-GCM. Ciphertext is prefixed with version of key it is
+// encrypted with, so keys can be rotated: new values are encrypted with the current key and
+// previous keys are kept only for decrypting values until they are encrypted again.
+type Cipher struct {
+	keys    map[uint32]cipher.AEAD
+	version uint32
+}
+
+func NewCipher(keys map[uint32][]byte, version uint32) (*Cipher, error) {
+	if _, ok := keys[version]; !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version)
+	}
+
+	aeads := make(map[uint32]cipher.AEAD, len(keys))
+
+	for v, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("%w: key %d must be %d bytes", ErrInvalidKey, v, KeySize)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating cipher for key %d: %w", v, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating gcm for key %d: %w", v, err)
+		}
+
+		aeads[v] = aead
+	}
+
+	return &Cipher{
+		keys:    aeads,
+		version: version,
+	}, nil
+}
+
+// Encrypt returns ciphertext in format "v<key version>:<base64 nonce and sealed value>".
+// Associated data isn't stored, but the same data is required for decryption, e.g. id of
+// row the value belongs to, so ciphertext copied to another row can't be decrypted.
+func (c *Cipher) Encrypt(plaintext string, associatedData []byte) (string, error) {
+	aead := c.keys[c.version]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("error while generating nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), associatedData)
+
+	return fmt.Sprintf(
+		"%s%d%s%s", versionPrefix, c.version, versionSeparator, base64.StdEncoding.EncodeToString(sealed),
+	), nil
+}
+
+func (c *Cipher) Decrypt(ciphertext string, associatedData []byte) (string, error) {
+	version, data, err := split(ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	aead, ok := c.keys[version]
+	if !ok {
+		return "", fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], associatedData)
+	if err != nil {
+		return "", ErrDecryptionFailed
+	}
+
+	return string(plaintext), nil
+}
+
+// IsCurrent reports whether value is encrypted with the current key. Values encrypted with
+// previous keys and not encrypted values should be encrypted again.
+func (c *Cipher) IsCurrent(ciphertext string) bool {
+	version, _, err := split(ciphertext)
+
+	return err == nil && version == c.version
+}
+
+// IsEncrypted reports whether value has ciphertext format.
+func IsEncrypted(value string) bool {
+	_, _, err := split(value)
+
+	return err == nil
+}
+
+func split(ciphertext string) (uint32, string, error) {
+	if !strings.HasPrefix(ciphertext, versionPrefix) {
+		return 0, "", ErrInvalidCiphertext
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(ciphertext, versionPrefix), versionSeparator, partsCount)
+	if len(parts) != partsCount {
+		return 0, "", ErrInvalidCiphertext
+	}
+
+	version, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, "", ErrInvalidCiphertext
+	}
+
+	return uint32(version), parts[1], nil
+}
+
+// BlindIndex computes keyed hash of values, so encrypted values can be looked up and
+// checked for uniqueness without decryption.
+type BlindIndex struct {
+	key []byte
+}
+
+func NewBlindIndex(key []byte) (*BlindIndex, error) {
+	if len(key) < KeySize {
+		return nil, ErrShortBlindIndexKey
+	}
+
+	return &BlindIndex{key: key}, nil
+}
+
+// Hash returns hex encoded hmac-sha256 of value.
+func (b *BlindIndex) Hash(value string) string {
+	mac := hmac.New(sha256.New, b.key)
+	mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ParseKeys parses keys in format "<version>:<base64 key>,<version>:<base64 key>".
+func ParseKeys(value string) (map[uint32][]byte, error) {
+	keys := make(map[uint32][]byte)
+
+	for _, pair := range strings.Split(value, keysSeparator) {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, versionSeparator, partsCount)
+		if len(parts) != partsCount {
+			return nil, ErrInvalidKeysFormat
+		}
+
+		version, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return nil, ErrInvalidKeysFormat
+		}
+
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %d isn't base64 encoded", ErrInvalidKey, version)
+		}
+
+		keys[uint32(version)] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrInvalidKeysFormat
+	}
+
+	return keys, nil
+}
+
+// LatestVersion returns the greatest key version.
+func LatestVersion(keys map[uint32][]byte) uint32 {
+	var latest uint32
+
+	for version := range keys {
+		if version > latest {
+			latest = version
+		}
+	}
+
+	return latest
+}
+
+// NewCipherFromConfig creates cipher of keys in ParseKeys format. Empty version selects
+// the latest key.
+func NewCipherFromConfig(keysValue string, versionValue string) (*Cipher, error) {
+	if strings.TrimSpace(keysValue) == "" {
+		return nil, ErrKeysNotSet
+	}
+
+	keys, err := ParseKeys(keysValue)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing encryption keys: %w", err)
+	}
+
+	version := LatestVersion(keys)
+
+	if versionValue != "" {
+		v, err := strconv.ParseUint(versionValue, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing encryption key version: %w", err)
+		}
+
+		version = uint32(v)
+	}
+
+	return NewCipher(keys, version)
+}
@@ -0,0 +1,260 @@
+package encryption_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/encryption"
+)
+
+func key(b byte) []byte {
+	return bytes.Repeat([]byte{b}, encryption.KeySize)
+}
+
+func TestCipher(t *testing.T) {
+	t.Parallel()
+
+	oldCipher, err := encryption.NewCipher(map[uint32][]byte{1: key(1)}, 1)
+	assert.NoError(t, err)
+
+	rotatedCipher, err := encryption.NewCipher(map[uint32][]byte{1: key(1), 2: key(2)}, 2)
+	assert.NoError(t, err)
+
+	oldCiphertext, err := oldCipher.Encrypt("12345678901", nil)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		ciphertext func() string
+		plaintext  string
+		isCurrent  bool
+		err        error
+	}{
+		{
+			name: "encrypted with current key",
+			ciphertext: func() string {
+				c, _ := rotatedCipher.Encrypt("12345678901", nil)
+
+				return c
+			},
+			plaintext: "12345678901",
+			isCurrent: true,
+		},
+		{
+			name: "encrypted with other associated data",
+			ciphertext: func() string {
+				c, _ := rotatedCipher.Encrypt("12345678901", []byte("1"))
+
+				return c
+			},
+			isCurrent: true,
+			err:       encryption.ErrDecryptionFailed,
+		},
+		{
+			name:       "encrypted with previous key",
+			ciphertext: func() string { return oldCiphertext },
+			plaintext:  "12345678901",
+		},
+		{
+			name: "encrypted with unknown key",
+			ciphertext: func() string {
+				return "v3" + strings.TrimPrefix(oldCiphertext, "v1")
+			},
+			err: encryption.ErrUnknownKeyVersion,
+		},
+		{
+			name: "tampered ciphertext",
+			ciphertext: func() string {
+				sealed, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(oldCiphertext, "v1:"))
+				sealed[len(sealed)-1] ^= 1
+
+				return "v1:" + base64.StdEncoding.EncodeToString(sealed)
+			},
+			err: encryption.ErrDecryptionFailed,
+		},
+		{
+			name:       "plain text",
+			ciphertext: func() string { return "12345678901" },
+			err:        encryption.ErrInvalidCiphertext,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ciphertext := tc.ciphertext()
+
+			plaintext, err := rotatedCipher.Decrypt(ciphertext, nil)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.plaintext, plaintext)
+			assert.Equal(t, tc.isCurrent, rotatedCipher.IsCurrent(ciphertext))
+		})
+	}
+}
+
+func TestCipherNonce(t *testing.T) {
+	t.Parallel()
+
+	c, err := encryption.NewCipher(map[uint32][]byte{1: key(1)}, 1)
+	assert.NoError(t, err)
+
+	first, err := c.Encrypt("12345678901", nil)
+	assert.NoError(t, err)
+
+	second, err := c.Encrypt("12345678901", nil)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestNewCipher(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		keys    map[uint32][]byte
+		version uint32
+		err     error
+	}{
+		{
+			name:    "valid keys",
+			keys:    map[uint32][]byte{1: key(1), 2: key(2)},
+			version: 1,
+		},
+		{
+			name:    "missing current key",
+			keys:    map[uint32][]byte{1: key(1)},
+			version: 2,
+			err:     encryption.ErrUnknownKeyVersion,
+		},
+		{
+			name:    "short key",
+			keys:    map[uint32][]byte{1: key(1)[:16]},
+			version: 1,
+			err:     encryption.ErrInvalidKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := encryption.NewCipher(tc.keys, tc.version)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	encoded := base64.StdEncoding.EncodeToString(key(1))
+
+	testCases := []struct {
+		name  string
+		value string
+		keys  map[uint32][]byte
+		err   error
+	}{
+		{
+			name:  "several keys",
+			value: fmt.Sprintf("1:%s, 2:%s", encoded, encoded),
+			keys:  map[uint32][]byte{1: key(1), 2: key(1)},
+		},
+		{
+			name:  "empty value",
+			value: "",
+			err:   encryption.ErrInvalidKeysFormat,
+		},
+		{
+			name:  "missing version",
+			value: encoded,
+			err:   encryption.ErrInvalidKeysFormat,
+		},
+		{
+			name:  "not base64 key",
+			value: "1:not base64",
+			err:   encryption.ErrInvalidKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := encryption.ParseKeys(tc.value)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.keys, keys)
+		})
+	}
+}
+
+func TestSnils(t *testing.T) {
+	t.Parallel()
+
+	snils, err := encryption.NewSnils(&config.Encryption{
+		SnilsKeys:     "1:" + base64.StdEncoding.EncodeToString(key(1)),
+		SnilsIndexKey: base64.StdEncoding.EncodeToString(key(2)),
+	})
+	assert.NoError(t, err)
+
+	first, err := snils.Encrypt(1, "12345678901")
+	assert.NoError(t, err)
+
+	second, err := snils.Encrypt(2, "12345678901")
+	assert.NoError(t, err)
+
+	other, err := snils.Encrypt(1, "12345678902")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "***-***-789 01", first.Mask)
+	assert.Equal(t, first.Hash, second.Hash)
+	assert.NotEqual(t, first.Hash, other.Hash)
+	assert.NotContains(t, first.Ciphertext, "12345678901")
+
+	plaintext, err := snils.Decrypt(1, first.Ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901", plaintext)
+	assert.True(t, snils.IsCurrent(1, first.Ciphertext))
+
+	_, err = snils.Decrypt(2, first.Ciphertext)
+	assert.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+	assert.False(t, snils.IsCurrent(2, first.Ciphertext))
+
+	empty, err := snils.Encrypt(1, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &encryption.EncryptedSnils{}, empty)
+
+	_, err = encryption.NewSnils(&config.Encryption{SnilsKeys: "1:" + base64.StdEncoding.EncodeToString(key(1))})
+	assert.ErrorIs(t, err, encryption.ErrKeysNotSet)
+}
+
+func TestTOTPSecrets(t *testing.T) {
+	t.Parallel()
+
+	secrets, err := encryption.NewTOTPSecrets(&config.Encryption{
+		TOTPKeys: "1:" + base64.StdEncoding.EncodeToString(key(1)),
+	})
+	assert.NoError(t, err)
+
+	ciphertext, err := secrets.Encrypt("JBSWY3DPEHPK3PXP")
+	assert.NoError(t, err)
+	assert.NotContains(t, ciphertext, "JBSWY3DPEHPK3PXP")
+
+	secret, err := secrets.Decrypt(ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", secret)
+
+	_, err = encryption.NewTOTPSecrets(&config.Encryption{})
+	assert.ErrorIs(t, err, encryption.ErrKeysNotSet)
+}
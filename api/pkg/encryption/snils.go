@@ -0,0 +1,134 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+const (
+	snilsLength      = 11
+	snilsMaskFormat  = "***-***-%s %s"
+	snilsVisibleFrom = 6
+	snilsCheckSumAt  = 9
+)
+
+// EncryptedSnils is SNILS prepared for storing: ciphertext, blind index used for uniqueness
+// checks and masked value shown to user.
+type EncryptedSnils struct {
+	Ciphertext string
+	Hash       string
+	Mask       string
+}
+
+// Snils encrypts SNILS of users. Keys are configured by config.Encryption. Ciphertext is
+// bound to id of user, so SNILS copied to another user row can't be decrypted.
+type Snils struct {
+	cipher *Cipher
+	index  *BlindIndex
+}
+
+func NewSnils(cfg *config.Encryption) (*Snils, error) {
+	cipher, err := NewCipherFromConfig(cfg.SnilsKeys, cfg.SnilsKeyVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating snils cipher: %w", err)
+	}
+
+	if cfg.SnilsIndexKey == "" {
+		return nil, fmt.Errorf("%w: snils index key isn't set", ErrKeysNotSet)
+	}
+
+	indexKey, err := base64.StdEncoding.DecodeString(cfg.SnilsIndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: snils index key isn't base64 encoded", ErrInvalidKey)
+	}
+
+	index, err := NewBlindIndex(indexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snils{
+		cipher: cipher,
+		index:  index,
+	}, nil
+}
+
+// Encrypt prepares SNILS of user for storing. Empty SNILS, e.g. of users created by identity
+// provider, stays empty, so it doesn't take part in uniqueness checks.
+func (s *Snils) Encrypt(userID uint, snils string) (*EncryptedSnils, error) {
+	if snils == "" {
+		return &EncryptedSnils{}, nil
+	}
+
+	ciphertext, err := s.cipher.Encrypt(snils, associatedUserID(userID))
+	if err != nil {
+		return nil, fmt.Errorf("error while encrypting snils: %w", err)
+	}
+
+	return &EncryptedSnils{
+		Ciphertext: ciphertext,
+		Hash:       s.Hash(snils),
+		Mask:       MaskSnils(snils),
+	}, nil
+}
+
+func (s *Snils) Decrypt(userID uint, ciphertext string) (string, error) {
+	if ciphertext == "" {
+		return "", nil
+	}
+
+	snils, err := s.cipher.Decrypt(ciphertext, associatedUserID(userID))
+	if err != nil {
+		return "", fmt.Errorf("error while decrypting snils: %w", err)
+	}
+
+	return snils, nil
+}
+
+// DecryptUnbound decrypts SNILS encrypted before ciphertexts were bound to users, it is
+// used only for encrypting such SNILS again.
+func (s *Snils) DecryptUnbound(ciphertext string) (string, error) {
+	snils, err := s.cipher.Decrypt(ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("error while decrypting unbound snils: %w", err)
+	}
+
+	return snils, nil
+}
+
+// Hash returns blind index of SNILS.
+func (s *Snils) Hash(snils string) string {
+	return s.index.Hash(snils)
+}
+
+// IsCurrent reports whether stored SNILS is encrypted with the current key and bound to user.
+func (s *Snils) IsCurrent(userID uint, ciphertext string) bool {
+	if ciphertext == "" {
+		return true
+	}
+
+	if !s.cipher.IsCurrent(ciphertext) {
+		return false
+	}
+
+	_, err := s.Decrypt(userID, ciphertext)
+
+	return err == nil
+}
+
+func associatedUserID(userID uint) []byte {
+	return []byte(strconv.FormatUint(uint64(userID), 10))
+}
+
+// MaskSnils hides all digits of SNILS except of the last three and check sum,
+// e.g. 12345678901 becomes ***-***-789 01.
+func MaskSnils(snils string) string {
+	if len(snils) != snilsLength {
+		return ""
+	}
+
+	return fmt.Sprintf(snilsMaskFormat, snils[snilsVisibleFrom:snilsCheckSumAt], snils[snilsCheckSumAt:])
+}
@@ -0,0 +1,39 @@
+package encryption
+
+import (
+	"fmt"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+// TOTPSecrets encrypts TOTP secrets of users. Keys are configured by config.Encryption.
+type TOTPSecrets struct {
+	cipher *Cipher
+}
+
+func NewTOTPSecrets(cfg *config.Encryption) (*TOTPSecrets, error) {
+	cipher, err := NewCipherFromConfig(cfg.TOTPKeys, cfg.TOTPKeyVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating totp cipher: %w", err)
+	}
+
+	return &TOTPSecrets{cipher: cipher}, nil
+}
+
+func (t *TOTPSecrets) Encrypt(secret string) (string, error) {
+	ciphertext, err := t.cipher.Encrypt(secret, nil)
+	if err != nil {
+		return "", fmt.Errorf("error while encrypting totp secret: %w", err)
+	}
+
+	return ciphertext, nil
+}
+
+func (t *TOTPSecrets) Decrypt(ciphertext string) (string, error) {
+	secret, err := t.cipher.Decrypt(ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("error while decrypting totp secret: %w", err)
+	}
+
+	return secret, nil
+}
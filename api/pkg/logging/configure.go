@@ -0,0 +1,45 @@
+package logging
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+const textTimestampFormat = "2006-01-02 15:04:05"
+
+// Configure sets level and format of logs, secrets are redacted from logs in both formats.
+func Configure(cfg *config.Logging) error {
+	level, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return fmt.Errorf("error while parsing logging level: %w", err)
+	}
+
+	var formatter logrus.Formatter
+
+	switch cfg.Format {
+	case FormatText:
+		formatter = &logrus.TextFormatter{
+			ForceColors:     true,
+			TimestampFormat: textTimestampFormat,
+			FullTimestamp:   true,
+		}
+	case FormatJSON:
+		formatter = &logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano}
+	default:
+		return fmt.Errorf("unknown logging format: %s", cfg.Format)
+	}
+
+	logrus.SetLevel(level)
+	logrus.SetFormatter(&redactingFormatter{formatter: formatter})
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package logging
+
+import "context"
+
+type contextKey int
+
+const (
+	requestIDContextKey contextKey = iota
+	userIDContextKey
+)
+
+// ContextWithRequestID returns context with id of request, it is logged by loggers
+// with this context.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+// RequestIDFromContext returns id of request set by ContextWithRequestID, it is empty if it isn't set.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey).(string)
+
+	return requestID
+}
+
+// ContextWithUserID returns context with id of identified user, it is logged by loggers
+// with this context.
+func ContextWithUserID(ctx context.Context, userID uint) context.Context {
+	return context.WithValue(ctx, userIDContextKey, userID)
+}
+
+// UserIDFromContext returns id of user set by ContextWithUserID.
+func UserIDFromContext(ctx context.Context) (uint, bool) {
+	userID, ok := ctx.Value(userIDContextKey).(uint)
+
+	return userID, ok
+}
@@ -1,41 +1,96 @@
 package logging
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	componentField = "component"
+	requestIDField = "request_id"
+	userIDField    = "user_id"
+	traceIDField   = "trace_id"
+	spanIDField    = "span_id"
 )
 
+// Fields are contextual fields of log entries, e.g. url or duration of request.
+type Fields map[string]interface{}
+
+// Logger writes entries of component named by prefix. Entries carry fields added by WithField,
+// WithFields and WithContext, so they can be filtered in structured output.
 type Logger struct {
-	prefix string
+	entry *logrus.Entry
 }
 
 func NewLogger(prefix string) *Logger {
-	return &Logger{prefix: prefix}
+	return &Logger{entry: logrus.WithField(componentField, prefix)}
+}
+
+// WithContext returns logger with request id, user id and ids of trace and span of context,
+// so entries written while handling request are tied to it and to its trace.
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	fields := Fields{}
+
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		fields[requestIDField] = requestID
+	}
+
+	if userID, ok := UserIDFromContext(ctx); ok {
+		fields[userIDField] = userID
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields[traceIDField] = spanContext.TraceID().String()
+		fields[spanIDField] = spanContext.SpanID().String()
+	}
+
+	return l.WithFields(fields)
+}
+
+func (l *Logger) WithField(key string, value interface{}) *Logger {
+	return &Logger{entry: l.entry.WithField(key, value)}
+}
+
+func (l *Logger) WithFields(fields Fields) *Logger {
+	return &Logger{entry: l.entry.WithFields(logrus.Fields(fields))}
 }
 
 func (l *Logger) Trace(err error) {
-	logrus.Tracef("%s | %s", l.prefix, err.Error())
+	l.entry.Trace(err.Error())
 }
 
 func (l *Logger) Debug(err error) {
-	logrus.Debugf("%s | %s", l.prefix, err.Error())
+	l.entry.Debug(err.Error())
 }
 
 func (l *Logger) Info(err error) {
-	logrus.Infof("%s | %s", l.prefix, err.Error())
+	l.entry.Info(err.Error())
 }
 
 func (l *Logger) Warn(err error) {
-	logrus.Warnf("%s | %s", l.prefix, err.Error())
+	l.entry.Warn(err.Error())
 }
 
 func (l *Logger) Error(err error) {
-	logrus.Errorf("%s | %s", l.prefix, err.Error())
+	l.entry.Error(err.Error())
 }
 
 func (l *Logger) Fatal(err error) {
-	logrus.Fatalf("%s | %s", l.prefix, err.Error())
+	l.entry.Fatal(err.Error())
 }
 
 func (l *Logger) Panic(err error) {
-	logrus.Panicf("%s | %s", l.prefix, err.Error())
+	l.entry.Panic(err.Error())
+}
+
+// Debugf writes debug message, it is used for events rather than errors, e.g. finished requests.
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.entry.Debugf(format, args...)
+}
+
+// Infof writes info message, it is used for events rather than errors, e.g. finished requests.
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.entry.Infof(format, args...)
 }
@@ -0,0 +1,101 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+func TestLogging_Configure(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		cfg         config.Logging
+		expectedErr bool
+	}{
+		{
+			name: "json format",
+			cfg:  config.Logging{Level: "info", Format: logging.FormatJSON},
+		},
+		{
+			name:        "unknown level",
+			cfg:         config.Logging{Level: "verbose", Format: logging.FormatJSON},
+			expectedErr: true,
+		},
+		{
+			name:        "unknown format",
+			cfg:         config.Logging{Level: "info", Format: "xml"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := logging.Configure(&tc.cfg)
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestLogger_WithContext(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, logging.Configure(&config.Logging{Level: "info", Format: logging.FormatJSON}))
+
+	ctx := logging.ContextWithUserID(logging.ContextWithRequestID(context.Background(), "request"), 42)
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.NoError(t, err)
+
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	assert.NoError(t, err)
+
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	assert.Equal(t, "request", logging.RequestIDFromContext(ctx))
+
+	userID, ok := logging.UserIDFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, uint(42), userID)
+
+	_, ok = logging.UserIDFromContext(context.Background())
+	assert.False(t, ok)
+
+	var output bytes.Buffer
+
+	logrus.SetOutput(&output)
+	defer logrus.SetOutput(os.Stderr)
+
+	logging.NewLogger("parsing services").
+		WithContext(ctx).
+		WithField("url", "https://rating.example/list?token=secret").
+		Error(errors.New("user 123-456-789 01 not found"))
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(output.Bytes(), &entry))
+
+	assert.Equal(t, "parsing services", entry["component"])
+	assert.Equal(t, "request", entry["request_id"])
+	assert.Equal(t, float64(42), entry["user_id"])
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entry["trace_id"])
+	assert.Equal(t, "00f067aa0ba902b7", entry["span_id"])
+	assert.Equal(t, "user [REDACTED] not found", entry["msg"])
+	assert.Equal(t, "https://rating.example/list?[REDACTED]", entry["url"])
+	assert.Equal(t, "error", entry["level"])
+}
@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+const redacted = "[REDACTED]"
+
+// secretPatterns match secrets which shouldn't get to logs: SNILS (formatted and raw), jwt
+// and personal access tokens, bearer authorization, token query params and telegram bot tokens.
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\b\d{3}-\d{3}-\d{3} \d{2}\b`),
+	regexp.MustCompile(`\b\d{11}\b`),
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+	regexp.MustCompile(`rlmp_pat_[A-Za-z0-9_-]+`),
+	regexp.MustCompile(`(?i)bearer\s+\S+`),
+	regexp.MustCompile(`(?i)\b[a-z_]*token=[^&\s]+`),
+	regexp.MustCompile(`\bbot\d+:[A-Za-z0-9_-]+`),
+}
+
+// Redact replaces secrets in s, it is applied to every log entry.
+func Redact(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, redacted)
+	}
+
+	return s
+}
+
+// redactingFormatter redacts message and string fields of entries before formatting.
+type redactingFormatter struct {
+	formatter logrus.Formatter
+}
+
+func (f *redactingFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, len(entry.Data))
+
+	for key, value := range entry.Data {
+		switch v := value.(type) {
+		case string:
+			data[key] = Redact(v)
+		case error:
+			data[key] = Redact(v.Error())
+		default:
+			data[key] = value
+		}
+	}
+
+	redactedEntry := *entry
+	redactedEntry.Data = data
+	redactedEntry.Message = Redact(entry.Message)
+
+	return f.formatter.Format(&redactedEntry)
+}
@@ -0,0 +1,115 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+	Headers map[string]string
+}
+
+type Mailer struct {
+	address string
+	from    string
+	auth    smtp.Auth
+}
+
+func New(cfg *config.Email) *Mailer {
+	var auth smtp.Auth
+	if cfg.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+
+	return &Mailer{
+		address: net.JoinHostPort(cfg.SMTPHost, cfg.SMTPPort),
+		from:    cfg.From,
+		auth:    auth,
+	}
+}
+
+func (m *Mailer) Send(message Message) error {
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	body, err := m.build(message)
+	if err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(m.address, m.auth, from.Address, []string{message.To}, body); err != nil {
+		return fmt.Errorf("error while sending email: %w", err)
+	}
+
+	return nil
+}
+
+// build returns multipart/alternative message with text and html parts.
+func (m *Mailer) build(message Message) ([]byte, error) {
+	var (
+		buf  bytes.Buffer
+		body bytes.Buffer
+	)
+
+	writer := multipart.NewWriter(&body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain; charset=UTF-8", content: message.Text},
+		{contentType: "text/html; charset=UTF-8", content: message.HTML},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while creating message part: %w", err)
+		}
+
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("error while writing message part: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error while closing message writer: %w", err)
+	}
+
+	headers := map[string]string{
+		"From":         m.from,
+		"To":           message.To,
+		"Subject":      mime.QEncoding.Encode("UTF-8", message.Subject),
+		"Date":         time.Now().Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+		"Content-Type": fmt.Sprintf("multipart/alternative; boundary=%s", writer.Boundary()),
+	}
+	for k, v := range message.Headers {
+		headers[k] = v
+	}
+
+	for k, v := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", k, v)
+	}
+
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,120 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	subjectTemplate = "subject"
+	textTemplate    = "text"
+
+	textTemplateExt = ".txt"
+	htmlTemplateExt = ".html"
+)
+
+type template struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// Templates are email templates loaded from folder with a subfolder per language.
+// Every template consists of "<name>.txt" file which defines "subject" and "text"
+// templates and "<name>.html" file with html version of the message.
+type Templates struct {
+	defaultLanguage string
+	templates       map[string]map[string]template
+}
+
+func LoadTemplates(path string, defaultLanguage string) (*Templates, error) {
+	languages, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading email templates folder: %w", err)
+	}
+
+	t := &Templates{
+		defaultLanguage: defaultLanguage,
+		templates:       make(map[string]map[string]template),
+	}
+
+	for _, language := range languages {
+		if !language.IsDir() {
+			continue
+		}
+
+		if err := t.loadLanguage(filepath.Join(path, language.Name()), language.Name()); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := t.templates[defaultLanguage]; !ok {
+		return nil, fmt.Errorf("there are no email templates for default language: %s", defaultLanguage)
+	}
+
+	return t, nil
+}
+
+func (t *Templates) loadLanguage(path string, language string) error {
+	files, err := filepath.Glob(filepath.Join(path, "*"+textTemplateExt))
+	if err != nil {
+		return fmt.Errorf("error while listing email templates: %w", err)
+	}
+
+	t.templates[language] = make(map[string]template)
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), textTemplateExt)
+
+		text, err := texttemplate.ParseFiles(file)
+		if err != nil {
+			return fmt.Errorf("error while parsing email template %s: %w", file, err)
+		}
+
+		html, err := htmltemplate.ParseFiles(filepath.Join(path, name+htmlTemplateExt))
+		if err != nil {
+			return fmt.Errorf("error while parsing email template %s: %w", file, err)
+		}
+
+		t.templates[language][name] = template{text: text, html: html}
+	}
+
+	return nil
+}
+
+// Render executes template for language falling back to default language.
+func (t *Templates) Render(language string, name string, data interface{}) (*Message, error) {
+	templates, ok := t.templates[language]
+	if !ok {
+		templates = t.templates[t.defaultLanguage]
+	}
+
+	tmpl, ok := templates[name]
+	if !ok {
+		return nil, fmt.Errorf("there is no email template: %s", name)
+	}
+
+	var subject, text, html bytes.Buffer
+
+	if err := tmpl.text.ExecuteTemplate(&subject, subjectTemplate, data); err != nil {
+		return nil, fmt.Errorf("error while rendering email subject: %w", err)
+	}
+
+	if err := tmpl.text.ExecuteTemplate(&text, textTemplate, data); err != nil {
+		return nil, fmt.Errorf("error while rendering email text: %w", err)
+	}
+
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return nil, fmt.Errorf("error while rendering email html: %w", err)
+	}
+
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"errors"
 	"strings"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
@@ -20,17 +19,45 @@ const headerPartsCount = 2
 func GetUserID(c *gin.Context) (uint, error) {
 	id, ok := c.Get(userCtx)
 	if !ok {
-		return 0, errors.New("invalid user id")
+		return 0, apierrors.Unidentified
 	}
 
 	userID, ok := id.(uint)
 	if !ok {
-		return 0, errors.New("invalid user id type")
+		return 0, apierrors.Unidentified
 	}
 
 	return userID, nil
 }
 
+func GetSessionID(c *gin.Context) (string, error) {
+	id, ok := c.Get(sessionCtx)
+	if !ok {
+		return "", apierrors.Unidentified
+	}
+
+	sessionID, ok := id.(string)
+	if !ok {
+		return "", apierrors.Unidentified
+	}
+
+	return sessionID, nil
+}
+
+func GetRole(c *gin.Context) (string, error) {
+	value, ok := c.Get(roleCtx)
+	if !ok {
+		return "", apierrors.Unidentified
+	}
+
+	role, ok := value.(string)
+	if !ok {
+		return "", apierrors.Unidentified
+	}
+
+	return role, nil
+}
+
 func GetAccessTokenFromRequest(c *gin.Context) (string, error) {
 	header := c.GetHeader(authorizationHeader)
 	if header == "" {
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+)
+
+const retryAfterHeader = "Retry-After"
+
+var errorLogger = logging.NewLogger("http errors")
+
+// ErrorHandler responds with RFC 7807 problem of the last error added by c.Error, so handlers
+// don't write error responses themselves. Status of response is defined by kind of error,
+// Retry-After header is set for errors which can be retried later. Problem has id of trace
+// of request, internal errors are recorded to its span.
+func ErrorHandler(c *gin.Context) {
+	c.Next()
+
+	if len(c.Errors) == 0 || c.Writer.Written() {
+		return
+	}
+
+	err := c.Errors.Last().Err
+	apiErr := apierrors.From(err)
+
+	logger := errorLogger.WithContext(c.Request.Context()).WithFields(logging.Fields{
+		"method": c.Request.Method,
+		"path":   c.Request.URL.Path,
+		"code":   apiErr.Code,
+	})
+	if apiErr.Kind.Status() >= http.StatusInternalServerError {
+		logger.Error(err)
+		tracing.RecordError(trace.SpanFromContext(c.Request.Context()), err)
+	} else {
+		logger.Warn(err)
+	}
+
+	var retryError *apierrors.RetryError
+	if errors.As(err, &retryError) {
+		c.Header(retryAfterHeader, strconv.Itoa(int(math.Ceil(retryError.RetryAfter.Seconds()))))
+	}
+
+	problem := apierrors.NewProblem(apiErr, c.Request.URL.Path, GetRequestID(c))
+	problem.TraceID = tracing.TraceID(c.Request.Context())
+
+	c.Header("Content-Type", apierrors.ProblemContentType)
+	c.AbortWithStatusJSON(apiErr.Kind.Status(), problem)
+}
+
+// abort stops request with error responded by ErrorHandler.
+func abort(c *gin.Context, err error) {
+	c.Abort()
+	_ = c.Error(err)
+}
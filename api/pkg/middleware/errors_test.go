@@ -0,0 +1,132 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+func TestMiddleware_ErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	notFound := apierrors.NewError(apierrors.KindNotFound, "direction_not_found", "direction not found")
+
+	testCases := []struct {
+		name               string
+		err                error
+		expectedStatus     int
+		expectedCode       string
+		expectedDetail     string
+		expectedRetryAfter string
+	}{
+		{
+			name:           "typed error",
+			err:            notFound,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "direction_not_found",
+			expectedDetail: "direction not found",
+		},
+		{
+			name:           "wrapped typed error",
+			err:            notFound.Wrap(errors.New("record not found")),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "direction_not_found",
+			expectedDetail: "direction not found",
+		},
+		{
+			name:               "retry error",
+			err:                apierrors.NewRetryError(apierrors.PersonalTokenRateLimitExceeded, 1500*time.Millisecond),
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedCode:       apierrors.PersonalTokenRateLimitExceeded.Code,
+			expectedDetail:     apierrors.PersonalTokenRateLimitExceeded.Message,
+			expectedRetryAfter: "2",
+		},
+		{
+			name:           "untyped error",
+			err:            errors.New("pq: connection refused"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   apierrors.Internal.Code,
+			expectedDetail: apierrors.Internal.Message,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := gin.New()
+			router.Use(middleware.RequestID, middleware.ErrorHandler)
+			router.GET("/directions/1", func(c *gin.Context) {
+				_ = c.Error(tc.err)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/directions/1", nil)
+			request.Header.Set(middleware.RequestIDHeader, "request")
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			var problem apierrors.Problem
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, apierrors.ProblemContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, tc.expectedRetryAfter, recorder.Header().Get("Retry-After"))
+			assert.Equal(t, tc.expectedStatus, problem.Status)
+			assert.Equal(t, tc.expectedCode, problem.Code)
+			assert.Equal(t, tc.expectedDetail, problem.Detail)
+			assert.Equal(t, "/directions/1", problem.Instance)
+			assert.Equal(t, "request", problem.RequestID)
+		})
+	}
+}
+
+func TestMiddleware_ErrorHandlerTraceID(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	provider := sdktrace.NewTracerProvider()
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	router := gin.New()
+	router.Use(
+		otelgin.Middleware(
+			"rlmp-api",
+			otelgin.WithTracerProvider(provider),
+			otelgin.WithPropagators(propagation.TraceContext{}),
+		),
+		middleware.ErrorHandler,
+	)
+	router.GET("/directions/1", func(c *gin.Context) {
+		_ = c.Error(errors.New("pq: connection refused"))
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/directions/1", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	var problem apierrors.Problem
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", problem.TraceID)
+}
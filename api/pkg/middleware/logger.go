@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+)
+
+var requestLogger = logging.NewLogger("http")
+
+// Logger writes entry of every request with its status, duration and client, entry has
+// request id and user id, so it can be correlated with entries of services. It replaces
+// gin logger, which writes query of requests (e.g. access token of websocket) as is.
+func Logger(c *gin.Context) {
+	start := time.Now()
+
+	c.Next()
+
+	requestLogger.WithContext(c.Request.Context()).WithFields(logging.Fields{
+		"method":      c.Request.Method,
+		"path":        c.Request.URL.Path,
+		"query":       c.Request.URL.RawQuery,
+		"route":       c.FullPath(),
+		"status":      c.Writer.Status(),
+		"duration_ms": time.Since(start).Milliseconds(),
+		"ip":          c.ClientIP(),
+		"user_agent":  c.Request.UserAgent(),
+		"size":        c.Writer.Size(),
+	}).Infof("%s %s", c.Request.Method, c.Request.URL.Path)
+}
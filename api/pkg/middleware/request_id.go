@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const (
+	// RequestIDHeader is header of request id, it is taken from request (e.g. set by proxy)
+	// or generated and returned in response.
+	RequestIDHeader = "X-Request-ID"
+
+	requestIDCtx    = "requestID"
+	requestIDLength = 16
+)
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID sets id of request, so audit log records and logs can be tied to the request.
+// Request id of client is accepted only if it is short and safe for logs. It is also set
+// to context of request, so loggers of services called with this context write it.
+func RequestID(c *gin.Context) {
+	requestID := ResolveRequestID(c.GetHeader(RequestIDHeader))
+
+	c.Set(requestIDCtx, requestID)
+	c.Request = c.Request.WithContext(logging.ContextWithRequestID(c.Request.Context(), requestID))
+	c.Header(RequestIDHeader, requestID)
+	c.Next()
+}
+
+// ResolveRequestID returns request id of client if it is valid, otherwise it generates new one.
+func ResolveRequestID(requestID string) string {
+	if requestIDPattern.MatchString(requestID) {
+		return requestID
+	}
+
+	id, err := random.Hex(requestIDLength)
+	if err != nil {
+		logrus.Error(err)
+	}
+
+	return id
+}
+
+// GetRequestID returns id of request set by RequestID, it is empty if middleware isn't used.
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDCtx)
+}
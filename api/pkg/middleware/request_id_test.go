@@ -0,0 +1,77 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+func TestMiddleware_RequestID(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name      string
+		requestID string
+		generated bool
+	}{
+		{
+			name:      "request id of client",
+			requestID: "a1b2-c3d4.e5_f6",
+		},
+		{
+			name:      "missing request id",
+			requestID: "",
+			generated: true,
+		},
+		{
+			name:      "too long request id",
+			requestID: strings.Repeat("a", 65),
+			generated: true,
+		},
+		{
+			name:      "request id with unsafe characters",
+			requestID: "id\tforged log line",
+			generated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var contextRequestID string
+
+			router := gin.New()
+			router.Use(middleware.RequestID)
+			router.GET("/", func(c *gin.Context) {
+				contextRequestID = middleware.GetRequestID(c)
+				c.Status(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set(middleware.RequestIDHeader, tc.requestID)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			responseRequestID := recorder.Header().Get(middleware.RequestIDHeader)
+			assert.Equal(t, contextRequestID, responseRequestID)
+
+			if tc.generated {
+				assert.Len(t, responseRequestID, 32)
+				assert.NotEqual(t, tc.requestID, responseRequestID)
+			} else {
+				assert.Equal(t, tc.requestID, responseRequestID)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+)
+
+// RequireRole allows requests of users with one of roles. It must be used after user
+// identity middleware, which sets role from access token.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, err := GetRole(c)
+		if err != nil {
+			abort(c, err)
+
+			return
+		}
+
+		for _, allowedRole := range roles {
+			if role == allowedRole {
+				return
+			}
+		}
+
+		abort(c, apierrors.InsufficientRole)
+	}
+}
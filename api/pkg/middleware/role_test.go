@@ -0,0 +1,92 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type tokenParser struct {
+	claims *authorization.TokenClaims
+}
+
+func (p tokenParser) ParseAccessToken(string) (*authorization.TokenClaims, error) {
+	return p.claims, nil
+}
+
+type revocationChecker struct{}
+
+func (revocationChecker) IsTokenRevoked(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func TestMiddleware_RequireRole(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name           string
+		role           string
+		allowedRoles   []string
+		expectedStatus int
+	}{
+		{
+			name:           "allowed role",
+			role:           authorization.RoleAdmin,
+			allowedRoles:   []string{authorization.RoleAdmin},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "one of allowed roles",
+			role:           authorization.RoleCounselor,
+			allowedRoles:   []string{authorization.RoleCounselor, authorization.RoleAdmin},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "not allowed role",
+			role:           authorization.RoleStudent,
+			allowedRoles:   []string{authorization.RoleAdmin},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "token without role",
+			role:           "",
+			allowedRoles:   []string{authorization.RoleAdmin},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			claims := &authorization.TokenClaims{UserID: 1, SessionID: "session", Role: tc.role}
+			claims.Id = "token"
+
+			identity := middleware.NewIdentity(tokenParser{claims: claims}, revocationChecker{}, nil, nil)
+
+			router := gin.New()
+			router.Use(middleware.ErrorHandler)
+			router.GET("/", identity.UserIdentity, middleware.RequireRole(tc.allowedRoles...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("Authorization", "Bearer token")
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+		})
+	}
+}
@@ -1,35 +1,172 @@
 package middleware
 
 import (
-	"net/http"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
-	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
 
 	"github.com/gin-gonic/gin"
-	"github.com/sirupsen/logrus"
 
 	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/logging"
 )
 
-const userCtx = "userID"
+const (
+	userCtx               = "userID"
+	sessionCtx            = "sessionID"
+	roleCtx               = "role"
+	accessTokenQueryParam = "access_token"
+)
+
+type AccessTokenParser interface {
+	ParseAccessToken(accessToken string) (*authorization.TokenClaims, error)
+}
+
+// RevocationChecker checks whether token with given id (jti) is revoked.
+type RevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// PersonalAccessTokenAuthenticator authenticates personal access token and counts its usage.
+type PersonalAccessTokenAuthenticator interface {
+	AuthenticatePersonalAccessToken(ctx context.Context, token string) (*authorization.PersonalAccessTokenClaims, error)
+}
+
+// RouteScopes are scopes required from personal access tokens by routes. Routes are keys
+// built by Route, personal access tokens aren't accepted by routes which aren't listed.
+type RouteScopes map[string]string
+
+// Route returns key of route in RouteScopes.
+func Route(method string, path string) string {
+	return fmt.Sprintf("%s %s", method, strings.TrimSuffix(path, "/"))
+}
+
+type Identity struct {
+	tokenParser        AccessTokenParser
+	revocationChecker  RevocationChecker
+	tokenAuthenticator PersonalAccessTokenAuthenticator
+	scopes             RouteScopes
+}
+
+func NewIdentity(
+	tokenParser AccessTokenParser,
+	revocationChecker RevocationChecker,
+	tokenAuthenticator PersonalAccessTokenAuthenticator,
+	scopes RouteScopes,
+) *Identity {
+	return &Identity{
+		tokenParser:        tokenParser,
+		revocationChecker:  revocationChecker,
+		tokenAuthenticator: tokenAuthenticator,
+		scopes:             scopes,
+	}
+}
 
-func UserIdentity(c *gin.Context) {
+func (i *Identity) UserIdentity(c *gin.Context) {
 	accessToken, err := GetAccessTokenFromRequest(c)
 	if err != nil {
-		logrus.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(err))
+		abort(c, err)
+
+		return
+	}
+
+	i.identify(c, accessToken)
+}
+
+// StreamUserIdentity identifies user of streaming requests. Browsers can't set headers
+// of WebSocket handshake, so access token may be passed by access_token query parameter.
+func (i *Identity) StreamUserIdentity(c *gin.Context) {
+	accessToken, err := GetAccessTokenFromRequest(c)
+	if err != nil {
+		accessToken = c.Query(accessTokenQueryParam)
+	}
+
+	if accessToken == "" {
+		abort(c, err)
+
+		return
+	}
+
+	i.identify(c, accessToken)
+}
+
+func (i *Identity) identify(c *gin.Context, accessToken string) {
+	if authorization.IsPersonalAccessToken(accessToken) {
+		i.identifyByPersonalAccessToken(c, accessToken)
+
+		return
+	}
+
+	tokenClaims, err := i.tokenParser.ParseAccessToken(accessToken)
+	if err != nil || tokenClaims.Id == "" {
+		abort(c, apierrors.InvalidAuthorizationHeader.Wrap(err))
+
+		return
+	}
+
+	revoked, err := i.revocationChecker.IsTokenRevoked(c.Request.Context(), tokenClaims.Id)
+	if err != nil {
+		abort(c, err)
+
+		return
+	}
+
+	if revoked {
+		abort(c, apierrors.RevokedAccessToken)
+
+		return
+	}
+
+	setUserID(c, tokenClaims.UserID)
+	c.Set(sessionCtx, tokenClaims.SessionID)
+	c.Set(roleCtx, tokenClaims.Role)
+}
+
+// identifyByPersonalAccessToken identifies user by personal access token. Token is accepted
+// only by routes with required scope, so it can't be used e.g. for changing password.
+func (i *Identity) identifyByPersonalAccessToken(c *gin.Context, token string) {
+	scope, ok := i.scopes[Route(c.Request.Method, c.FullPath())]
+	if !ok {
+		abort(c, apierrors.PersonalTokenNotAllowed)
 
 		return
 	}
 
-	tokenClaims, err := authorization.ParseToken(accessToken, config.Get().AuthTokens.AccessToken)
+	claims, err := i.tokenAuthenticator.AuthenticatePersonalAccessToken(c.Request.Context(), token)
 	if err != nil {
-		logrus.Error(err)
-		c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.NewAPIError(apierrors.InvalidAuthorizationHeader))
+		if errors.Is(err, authorization.ErrPersonalAccessTokenRateLimitExceeded) {
+			abort(c, apierrors.PersonalTokenRateLimitExceeded.Wrap(err))
+
+			return
+		}
+
+		if apierrors.From(err).Kind != apierrors.KindUnauthorized {
+			abort(c, err)
+
+			return
+		}
+
+		abort(c, apierrors.InvalidAuthorizationHeader.Wrap(err))
 
 		return
 	}
 
-	c.Set(userCtx, tokenClaims.UserID)
+	if !claims.HasScope(scope) {
+		abort(c, apierrors.InsufficientScope)
+
+		return
+	}
+
+	setUserID(c, claims.UserID)
+	c.Set(roleCtx, claims.Role)
+}
+
+// setUserID sets id of identified user to gin and request contexts, so it is logged
+// by loggers of services called with request context.
+func setUserID(c *gin.Context, userID uint) {
+	c.Set(userCtx, userID)
+	c.Request = c.Request.WithContext(logging.ContextWithUserID(c.Request.Context(), userID))
 }
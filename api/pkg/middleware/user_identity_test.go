@@ -0,0 +1,122 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/apierrors"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/middleware"
+)
+
+type tokenAuthenticator struct {
+	claims *authorization.PersonalAccessTokenClaims
+	err    error
+}
+
+func (a tokenAuthenticator) AuthenticatePersonalAccessToken(
+	context.Context, string,
+) (*authorization.PersonalAccessTokenClaims, error) {
+	return a.claims, a.err
+}
+
+func TestIdentity_PersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	scopes := middleware.RouteScopes{
+		middleware.Route(http.MethodGet, "/direction/"): authorization.ScopeReadDirections,
+	}
+
+	testCases := []struct {
+		name           string
+		path           string
+		authenticator  tokenAuthenticator
+		expectedStatus int
+	}{
+		{
+			name: "token with required scope",
+			path: "/direction/",
+			authenticator: tokenAuthenticator{
+				claims: &authorization.PersonalAccessTokenClaims{
+					UserID: 1,
+					Scopes: []string{authorization.ScopeReadProfile, authorization.ScopeReadDirections},
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "token without required scope",
+			path: "/direction/",
+			authenticator: tokenAuthenticator{
+				claims: &authorization.PersonalAccessTokenClaims{
+					UserID: 1,
+					Scopes: []string{authorization.ScopeReadProfile},
+				},
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "route without scope",
+			path: "/sessions",
+			authenticator: tokenAuthenticator{
+				claims: &authorization.PersonalAccessTokenClaims{
+					UserID: 1,
+					Scopes: authorization.Scopes,
+				},
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "rate limited token",
+			path:           "/direction/",
+			authenticator:  tokenAuthenticator{err: authorization.ErrPersonalAccessTokenRateLimitExceeded},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "invalid token",
+			path: "/direction/",
+			authenticator: tokenAuthenticator{
+				err: apierrors.NewError(apierrors.KindUnauthorized, "invalid_token", "invalid token"),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "authentication failure",
+			path:           "/direction/",
+			authenticator:  tokenAuthenticator{err: assert.AnError},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			identity := middleware.NewIdentity(tokenParser{}, revocationChecker{}, tc.authenticator, scopes)
+
+			router := gin.New()
+			router.Use(middleware.ErrorHandler)
+			handler := func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			}
+			router.GET("/direction/", identity.UserIdentity, handler)
+			router.GET("/sessions", identity.UserIdentity, handler)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("Authorization", "Bearer "+authorization.PersonalAccessTokenPrefix+"token")
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+		})
+	}
+}
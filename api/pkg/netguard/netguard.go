@@ -0,0 +1,134 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+var (
+	ErrSchemeNotAllowed  = errors.New("only http and https schemes are allowed")
+	ErrHostNotAllowed    = errors.New("host resolves to non-public address")
+	ErrHostNotResolvable = errors.New("host isn't resolvable")
+)
+
+// nonPublicNetworks are loopback, private, link-local, shared and reserved networks, requests to them
+// could reach internal services (e.g. redis or postgres of docker-compose) or cloud metadata endpoints.
+var nonPublicNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}
+
+// IsPublic reports whether ip is public unicast address.
+func IsPublic(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateURL checks that url has http or https scheme and its host resolves to public addresses only.
+func ValidateURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("error while parsing url: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrSchemeNotAllowed
+	}
+
+	_, err = resolvePublic(ctx, u.Hostname())
+
+	return err
+}
+
+func resolvePublic(ctx context.Context, host string) ([]net.IP, error) {
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addresses) == 0 {
+		return nil, ErrHostNotResolvable
+	}
+
+	ips := make([]net.IP, 0, len(addresses))
+	for _, address := range addresses {
+		if !IsPublic(address.IP) {
+			return nil, ErrHostNotAllowed
+		}
+
+		ips = append(ips, address.IP)
+	}
+
+	return ips, nil
+}
+
+// NewDialer returns dial function which connects to public addresses only. Host is resolved once
+// and connection is made to resolved address, so host can't be rebound to internal address after
+// the check.
+func NewDialer(timeout time.Duration) func(addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+
+	return func(addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("error while splitting address: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		ips, err := resolvePublic(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("error while resolving %s: %w", host, err)
+		}
+
+		var conn net.Conn
+		for _, ip := range ips {
+			conn, err = dialer.DialContext(ctx, "tcp", net.JoinHostPort(ip.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+		}
+
+		return nil, fmt.Errorf("error while dialing %s: %w", addr, err)
+	}
+}
@@ -0,0 +1,88 @@
+package netguard_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/netguard"
+)
+
+func TestNetguard_IsPublic(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "93.184.216.34", expected: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{ip: "127.0.0.1", expected: false},
+		{ip: "10.1.2.3", expected: false},
+		{ip: "172.28.0.10", expected: false},
+		{ip: "192.168.1.1", expected: false},
+		{ip: "169.254.169.254", expected: false},
+		{ip: "100.64.0.1", expected: false},
+		{ip: "0.0.0.0", expected: false},
+		{ip: "::1", expected: false},
+		{ip: "::ffff:127.0.0.1", expected: false},
+		{ip: "fd00::1", expected: false},
+		{ip: "fe80::1", expected: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.ip, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, netguard.IsPublic(net.ParseIP(tc.ip)))
+		})
+	}
+}
+
+func TestNetguard_ValidateURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		url         string
+		expectedErr error
+	}{
+		{name: "public ip", url: "https://93.184.216.34/hook"},
+		{name: "unknown scheme", url: "httpx://93.184.216.34/hook", expectedErr: netguard.ErrSchemeNotAllowed},
+		{name: "loopback", url: "http://127.0.0.1:6379", expectedErr: netguard.ErrHostNotAllowed},
+		{name: "localhost", url: "http://localhost/hook", expectedErr: netguard.ErrHostNotAllowed},
+		{name: "private", url: "http://10.0.0.5/hook", expectedErr: netguard.ErrHostNotAllowed},
+		{name: "link local", url: "http://169.254.169.254/latest", expectedErr: netguard.ErrHostNotAllowed},
+		{name: "ipv6 loopback", url: "http://[::1]/hook", expectedErr: netguard.ErrHostNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := netguard.ValidateURL(context.Background(), tc.url)
+			assert.True(t, errors.Is(err, tc.expectedErr), err)
+		})
+	}
+}
+
+func TestNetguard_NewDialer(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dial := netguard.NewDialer(time.Second)
+
+	_, err := dial(strings.TrimPrefix(server.URL, "http://"))
+	assert.True(t, errors.Is(err, netguard.ErrHostNotAllowed))
+}
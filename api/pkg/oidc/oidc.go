@@ -0,0 +1,169 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const (
+	codeVerifierLength       = 32
+	codeChallengeMethodS256  = "S256"
+	codeChallengeParam       = "code_challenge"
+	codeChallengeMethodParam = "code_challenge_method"
+	codeVerifierParam        = "code_verifier"
+	idTokenField             = "id_token"
+	defaultScopeEmail        = "email"
+	defaultScopeProfile      = "profile"
+)
+
+var (
+	ErrNoIDToken     = errors.New("token response doesn't contain id token")
+	ErrNonceMismatch = errors.New("id token nonce doesn't match")
+)
+
+// Identity is the end user authenticated by provider.
+type Identity struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	MiddleName    string `json:"middle_name"`
+}
+
+// Provider is OpenID Connect relying party of single identity provider. It uses
+// authorization code flow with PKCE. Provider metadata is discovered on first use,
+// so API starts even if identity provider is unavailable.
+type Provider struct {
+	cfg config.OIDCProvider
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewProvider(cfg config.OIDCProvider) *Provider {
+	return &Provider{cfg: cfg}
+}
+
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL returns URL of provider authorization endpoint user should be redirected to.
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	oauth2Config, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return oauth2Config.AuthCodeURL(
+		state,
+		oidc.Nonce(nonce),
+		oauth2.SetAuthURLParam(codeChallengeParam, codeChallenge(codeVerifier)),
+		oauth2.SetAuthURLParam(codeChallengeMethodParam, codeChallengeMethodS256),
+	), nil
+}
+
+// Exchange exchanges authorization code for tokens and returns identity from verified id token.
+func (p *Provider) Exchange(ctx context.Context, code string, nonce string, codeVerifier string) (*Identity, error) {
+	oauth2Config, verifier, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauth2Config.Exchange(ctx, code, oauth2.SetAuthURLParam(codeVerifierParam, codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error while exchanging authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra(idTokenField).(string)
+	if !ok {
+		return nil, ErrNoIDToken
+	}
+
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("error while verifying id token: %w", err)
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, ErrNonceMismatch
+	}
+
+	var identity Identity
+	if err := idToken.Claims(&identity); err != nil {
+		return nil, fmt.Errorf("error while parsing id token claims: %w", err)
+	}
+
+	return &identity, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth2 != nil {
+		return p.oauth2, p.verifier, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, p.cfg.Issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while discovering %s provider: %w", p.cfg.Name, err)
+	}
+
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{defaultScopeEmail, defaultScopeProfile}
+	}
+
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       append([]string{oidc.ScopeOpenID}, scopes...),
+	}
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID})
+
+	return p.oauth2, p.verifier, nil
+}
+
+// Providers are configured identity providers by their names.
+type Providers map[string]*Provider
+
+func NewProviders(cfg *config.OIDC) Providers {
+	providers := make(Providers, len(cfg.Providers))
+	for _, providerConfig := range cfg.Providers {
+		providers[providerConfig.Name] = NewProvider(providerConfig)
+	}
+
+	return providers
+}
+
+// NewCodeVerifier returns random PKCE code verifier (RFC 7636).
+func NewCodeVerifier() (string, error) {
+	b, err := random.Bytes(codeVerifierLength)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func codeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
@@ -0,0 +1,248 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/authorization"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/oidc"
+)
+
+const (
+	clientID    = "rlmp"
+	redirectURL = "http://localhost/oidc/callback"
+	keyID       = "mock"
+	subject     = "student-42"
+	code        = "authorization-code"
+)
+
+// mockProvider is a minimal OpenID Connect provider which authorizes every request
+// and issues RS256 id tokens for the same subject.
+type mockProvider struct {
+	server *httptest.Server
+	key    interface{}
+	jwks   authorization.JWKS
+
+	mu        sync.Mutex
+	challenge string
+	nonce     string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+
+	key, err := authorization.GenerateKey(keyID, "RS256", 2048)
+	assert.NoError(t, err)
+
+	keySet, err := authorization.NewKeySet(keyID, key)
+	assert.NoError(t, err)
+
+	keyPEM, err := authorization.MarshalPrivateKeyPEM(key)
+	assert.NoError(t, err)
+
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(keyPEM)
+	assert.NoError(t, err)
+
+	m := &mockProvider{key: privateKey, jwks: keySet.JWKS()}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", m.discovery)
+	mux.HandleFunc("/authorize", m.authorize)
+	mux.HandleFunc("/token", m.token)
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(m.jwks)
+	})
+
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+
+	return m
+}
+
+func (m *mockProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"issuer":                                m.server.URL,
+		"authorization_endpoint":                m.server.URL + "/authorize",
+		"token_endpoint":                        m.server.URL + "/token",
+		"jwks_uri":                              m.server.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (m *mockProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("client_id") != clientID {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	m.mu.Lock()
+	m.challenge = query.Get("code_challenge")
+	m.nonce = query.Get("nonce")
+	m.mu.Unlock()
+
+	redirect, _ := url.Parse(query.Get("redirect_uri"))
+	redirect.RawQuery = url.Values{"code": {code}, "state": {query.Get("state")}}.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (m *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	m.mu.Lock()
+	challenge, nonce := m.challenge, m.nonce
+	m.mu.Unlock()
+
+	hash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if r.PostForm.Get("code") != code || base64.RawURLEncoding.EncodeToString(hash[:]) != challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+		return
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            m.server.URL,
+		"aud":            clientID,
+		"sub":            subject,
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "student@school.example",
+		"email_verified": true,
+		"given_name":     "Ivan",
+		"family_name":    "Ivanov",
+	})
+	idToken.Header["kid"] = keyID
+
+	signed, err := idToken.SignedString(m.key)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     signed,
+	})
+}
+
+// authorize follows provider redirect and returns authorization code from callback URL.
+func authorize(t *testing.T, authCodeURL string, state string) string {
+	t.Helper()
+
+	client := http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+
+	response, err := client.Get(authCodeURL)
+	assert.NoError(t, err)
+
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusFound, response.StatusCode)
+
+	location, err := url.Parse(response.Header.Get("Location"))
+	assert.NoError(t, err)
+	assert.Equal(t, state, location.Query().Get("state"))
+
+	return location.Query().Get("code")
+}
+
+func TestOIDC_ProviderExchange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		exchangeNonce func(nonce string) string
+		verifier      func(verifier string) string
+		expectedError bool
+	}{
+		{
+			name:          "valid flow",
+			exchangeNonce: func(nonce string) string { return nonce },
+			verifier:      func(verifier string) string { return verifier },
+			expectedError: false,
+		},
+		{
+			name:          "invalid code verifier",
+			exchangeNonce: func(nonce string) string { return nonce },
+			verifier:      func(verifier string) string { return verifier + "x" },
+			expectedError: true,
+		},
+		{
+			name:          "nonce mismatch",
+			exchangeNonce: func(nonce string) string { return "other" + nonce },
+			verifier:      func(verifier string) string { return verifier },
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := newMockProvider(t)
+			provider := oidc.NewProvider(config.OIDCProvider{
+				Name:        "mock",
+				Issuer:      mock.server.URL,
+				ClientID:    clientID,
+				RedirectURL: redirectURL,
+			})
+
+			const (
+				state = "state"
+				nonce = "nonce"
+			)
+
+			codeVerifier, err := oidc.NewCodeVerifier()
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+
+			authCodeURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+			assert.NoError(t, err)
+
+			authorizationCode := authorize(t, authCodeURL, state)
+
+			identity, err := provider.Exchange(
+				ctx, authorizationCode, tc.exchangeNonce(nonce), tc.verifier(codeVerifier),
+			)
+			if tc.expectedError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, subject, identity.Subject)
+			assert.Equal(t, "student@school.example", identity.Email)
+			assert.True(t, identity.EmailVerified)
+			assert.Equal(t, "Ivan", identity.GivenName)
+			assert.Equal(t, "Ivanov", identity.FamilyName)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package random
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+)
+
+func Bytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("error while reading random bytes: %w", err)
+	}
+
+	return b, nil
+}
+
+func Hex(n int) (string, error) {
+	b, err := Bytes(n)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package rlmppb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AuthorizationClient is the client API for Authorization service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthorizationClient interface {
+	// SignIn returns tokens or two-factor challenge if user has two-factor authentication enabled.
+	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// SignInTwoFactor completes sign in by two-factor challenge.
+	SignInTwoFactor(ctx context.Context, in *SignInTwoFactorRequest, opts ...grpc.CallOption) (*Tokens, error)
+	// RefreshTokens rotates tokens, refresh token can be used only once.
+	RefreshTokens(ctx context.Context, in *RefreshTokensRequest, opts ...grpc.CallOption) (*Tokens, error)
+}
+
+type authorizationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthorizationClient(cc grpc.ClientConnInterface) AuthorizationClient {
+	return &authorizationClient{cc}
+}
+
+func (c *authorizationClient) SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Authorization/SignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorizationClient) SignInTwoFactor(ctx context.Context, in *SignInTwoFactorRequest, opts ...grpc.CallOption) (*Tokens, error) {
+	out := new(Tokens)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Authorization/SignInTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorizationClient) RefreshTokens(ctx context.Context, in *RefreshTokensRequest, opts ...grpc.CallOption) (*Tokens, error) {
+	out := new(Tokens)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Authorization/RefreshTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthorizationServer is the server API for Authorization service.
+// All implementations must embed UnimplementedAuthorizationServer
+// for forward compatibility
+type AuthorizationServer interface {
+	// SignIn returns tokens or two-factor challenge if user has two-factor authentication enabled.
+	SignIn(context.Context, *SignInRequest) (*SignInResponse, error)
+	// SignInTwoFactor completes sign in by two-factor challenge.
+	SignInTwoFactor(context.Context, *SignInTwoFactorRequest) (*Tokens, error)
+	// RefreshTokens rotates tokens, refresh token can be used only once.
+	RefreshTokens(context.Context, *RefreshTokensRequest) (*Tokens, error)
+	mustEmbedUnimplementedAuthorizationServer()
+}
+
+// UnimplementedAuthorizationServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthorizationServer struct {
+}
+
+func (UnimplementedAuthorizationServer) SignIn(context.Context, *SignInRequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignIn not implemented")
+}
+func (UnimplementedAuthorizationServer) SignInTwoFactor(context.Context, *SignInTwoFactorRequest) (*Tokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignInTwoFactor not implemented")
+}
+func (UnimplementedAuthorizationServer) RefreshTokens(context.Context, *RefreshTokensRequest) (*Tokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTokens not implemented")
+}
+func (UnimplementedAuthorizationServer) mustEmbedUnimplementedAuthorizationServer() {}
+
+// UnsafeAuthorizationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthorizationServer will
+// result in compilation errors.
+type UnsafeAuthorizationServer interface {
+	mustEmbedUnimplementedAuthorizationServer()
+}
+
+func RegisterAuthorizationServer(s grpc.ServiceRegistrar, srv AuthorizationServer) {
+	s.RegisterService(&Authorization_ServiceDesc, srv)
+}
+
+func _Authorization_SignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).SignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Authorization/SignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).SignIn(ctx, req.(*SignInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authorization_SignInTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).SignInTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Authorization/SignInTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).SignInTwoFactor(ctx, req.(*SignInTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authorization_RefreshTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).RefreshTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Authorization/RefreshTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).RefreshTokens(ctx, req.(*RefreshTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Authorization_ServiceDesc is the grpc.ServiceDesc for Authorization service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Authorization_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rlmp.v1.Authorization",
+	HandlerType: (*AuthorizationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignIn",
+			Handler:    _Authorization_SignIn_Handler,
+		},
+		{
+			MethodName: "SignInTwoFactor",
+			Handler:    _Authorization_SignInTwoFactor_Handler,
+		},
+		{
+			MethodName: "RefreshTokens",
+			Handler:    _Authorization_RefreshTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rlmp/v1/rlmp.proto",
+}
+
+// DirectionsClient is the client API for Directions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DirectionsClient interface {
+	// ListDirections returns page of directions catalogue.
+	ListDirections(ctx context.Context, in *ListDirectionsRequest, opts ...grpc.CallOption) (*ListDirectionsResponse, error)
+	// ListUserDirections returns directions tracked by user.
+	ListUserDirections(ctx context.Context, in *ListUserDirectionsRequest, opts ...grpc.CallOption) (*ListUserDirectionsResponse, error)
+	// AddUserDirection starts tracking direction, added is false if it is already tracked.
+	AddUserDirection(ctx context.Context, in *AddUserDirectionRequest, opts ...grpc.CallOption) (*AddUserDirectionResponse, error)
+	// RemoveUserDirection stops tracking direction.
+	RemoveUserDirection(ctx context.Context, in *RemoveUserDirectionRequest, opts ...grpc.CallOption) (*RemoveUserDirectionResponse, error)
+}
+
+type directionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDirectionsClient(cc grpc.ClientConnInterface) DirectionsClient {
+	return &directionsClient{cc}
+}
+
+func (c *directionsClient) ListDirections(ctx context.Context, in *ListDirectionsRequest, opts ...grpc.CallOption) (*ListDirectionsResponse, error) {
+	out := new(ListDirectionsResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Directions/ListDirections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directionsClient) ListUserDirections(ctx context.Context, in *ListUserDirectionsRequest, opts ...grpc.CallOption) (*ListUserDirectionsResponse, error) {
+	out := new(ListUserDirectionsResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Directions/ListUserDirections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directionsClient) AddUserDirection(ctx context.Context, in *AddUserDirectionRequest, opts ...grpc.CallOption) (*AddUserDirectionResponse, error) {
+	out := new(AddUserDirectionResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Directions/AddUserDirection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directionsClient) RemoveUserDirection(ctx context.Context, in *RemoveUserDirectionRequest, opts ...grpc.CallOption) (*RemoveUserDirectionResponse, error) {
+	out := new(RemoveUserDirectionResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Directions/RemoveUserDirection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DirectionsServer is the server API for Directions service.
+// All implementations must embed UnimplementedDirectionsServer
+// for forward compatibility
+type DirectionsServer interface {
+	// ListDirections returns page of directions catalogue.
+	ListDirections(context.Context, *ListDirectionsRequest) (*ListDirectionsResponse, error)
+	// ListUserDirections returns directions tracked by user.
+	ListUserDirections(context.Context, *ListUserDirectionsRequest) (*ListUserDirectionsResponse, error)
+	// AddUserDirection starts tracking direction, added is false if it is already tracked.
+	AddUserDirection(context.Context, *AddUserDirectionRequest) (*AddUserDirectionResponse, error)
+	// RemoveUserDirection stops tracking direction.
+	RemoveUserDirection(context.Context, *RemoveUserDirectionRequest) (*RemoveUserDirectionResponse, error)
+	mustEmbedUnimplementedDirectionsServer()
+}
+
+// UnimplementedDirectionsServer must be embedded to have forward compatible implementations.
+type UnimplementedDirectionsServer struct {
+}
+
+func (UnimplementedDirectionsServer) ListDirections(context.Context, *ListDirectionsRequest) (*ListDirectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirections not implemented")
+}
+func (UnimplementedDirectionsServer) ListUserDirections(context.Context, *ListUserDirectionsRequest) (*ListUserDirectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserDirections not implemented")
+}
+func (UnimplementedDirectionsServer) AddUserDirection(context.Context, *AddUserDirectionRequest) (*AddUserDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserDirection not implemented")
+}
+func (UnimplementedDirectionsServer) RemoveUserDirection(context.Context, *RemoveUserDirectionRequest) (*RemoveUserDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserDirection not implemented")
+}
+func (UnimplementedDirectionsServer) mustEmbedUnimplementedDirectionsServer() {}
+
+// UnsafeDirectionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DirectionsServer will
+// result in compilation errors.
+type UnsafeDirectionsServer interface {
+	mustEmbedUnimplementedDirectionsServer()
+}
+
+func RegisterDirectionsServer(s grpc.ServiceRegistrar, srv DirectionsServer) {
+	s.RegisterService(&Directions_ServiceDesc, srv)
+}
+
+func _Directions_ListDirections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectionsServer).ListDirections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Directions/ListDirections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectionsServer).ListDirections(ctx, req.(*ListDirectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Directions_ListUserDirections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserDirectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectionsServer).ListUserDirections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Directions/ListUserDirections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectionsServer).ListUserDirections(ctx, req.(*ListUserDirectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Directions_AddUserDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectionsServer).AddUserDirection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Directions/AddUserDirection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectionsServer).AddUserDirection(ctx, req.(*AddUserDirectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Directions_RemoveUserDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectionsServer).RemoveUserDirection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Directions/RemoveUserDirection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectionsServer).RemoveUserDirection(ctx, req.(*RemoveUserDirectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Directions_ServiceDesc is the grpc.ServiceDesc for Directions service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Directions_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rlmp.v1.Directions",
+	HandlerType: (*DirectionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDirections",
+			Handler:    _Directions_ListDirections_Handler,
+		},
+		{
+			MethodName: "ListUserDirections",
+			Handler:    _Directions_ListUserDirections_Handler,
+		},
+		{
+			MethodName: "AddUserDirection",
+			Handler:    _Directions_AddUserDirection_Handler,
+		},
+		{
+			MethodName: "RemoveUserDirection",
+			Handler:    _Directions_RemoveUserDirection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rlmp/v1/rlmp.proto",
+}
+
+// RatingsClient is the client API for Ratings service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatingsClient interface {
+	// GetRatings parses rating lists of directions tracked by user.
+	GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error)
+	// GetRatingHistory returns recorded changes of user position in rating list of direction.
+	GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryResponse, error)
+}
+
+type ratingsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatingsClient(cc grpc.ClientConnInterface) RatingsClient {
+	return &ratingsClient{cc}
+}
+
+func (c *ratingsClient) GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error) {
+	out := new(GetRatingsResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Ratings/GetRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratingsClient) GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryResponse, error) {
+	out := new(GetRatingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rlmp.v1.Ratings/GetRatingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingsServer is the server API for Ratings service.
+// All implementations must embed UnimplementedRatingsServer
+// for forward compatibility
+type RatingsServer interface {
+	// GetRatings parses rating lists of directions tracked by user.
+	GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error)
+	// GetRatingHistory returns recorded changes of user position in rating list of direction.
+	GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryResponse, error)
+	mustEmbedUnimplementedRatingsServer()
+}
+
+// UnimplementedRatingsServer must be embedded to have forward compatible implementations.
+type UnimplementedRatingsServer struct {
+}
+
+func (UnimplementedRatingsServer) GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatings not implemented")
+}
+func (UnimplementedRatingsServer) GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingHistory not implemented")
+}
+func (UnimplementedRatingsServer) mustEmbedUnimplementedRatingsServer() {}
+
+// UnsafeRatingsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatingsServer will
+// result in compilation errors.
+type UnsafeRatingsServer interface {
+	mustEmbedUnimplementedRatingsServer()
+}
+
+func RegisterRatingsServer(s grpc.ServiceRegistrar, srv RatingsServer) {
+	s.RegisterService(&Ratings_ServiceDesc, srv)
+}
+
+func _Ratings_GetRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingsServer).GetRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Ratings/GetRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingsServer).GetRatings(ctx, req.(*GetRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ratings_GetRatingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatingsServer).GetRatingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rlmp.v1.Ratings/GetRatingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatingsServer).GetRatingHistory(ctx, req.(*GetRatingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Ratings_ServiceDesc is the grpc.ServiceDesc for Ratings service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ratings_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rlmp.v1.Ratings",
+	HandlerType: (*RatingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRatings",
+			Handler:    _Ratings_GetRatings_Handler,
+		},
+		{
+			MethodName: "GetRatingHistory",
+			Handler:    _Ratings_GetRatingHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rlmp/v1/rlmp.proto",
+}
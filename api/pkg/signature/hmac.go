@@ -0,0 +1,106 @@
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	timestampKey = "t"
+	signatureKey = "v1"
+	partsCount   = 2
+)
+
+var (
+	ErrInvalidHeader    = errors.New("invalid signature header")
+	ErrInvalidToken     = errors.New("invalid signed token")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpiredTimestamp = errors.New("signature timestamp is out of tolerance")
+)
+
+// Sign returns signature header value in format "t=<unix timestamp>,v1=<hex hmac-sha256>",
+// where hmac is calculated over "<unix timestamp>.<payload>".
+func Sign(secret []byte, timestamp time.Time, payload []byte) string {
+	unix := timestamp.Unix()
+
+	return fmt.Sprintf("%s=%d,%s=%s", timestampKey, unix, signatureKey, compute(secret, unix, payload))
+}
+
+// Verify checks signature header created by Sign.
+func Verify(secret []byte, header string, payload []byte, tolerance time.Duration, now time.Time) error {
+	var (
+		unix      int64
+		signature string
+		err       error
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", partsCount)
+		if len(kv) != partsCount {
+			return ErrInvalidHeader
+		}
+
+		switch kv[0] {
+		case timestampKey:
+			if unix, err = strconv.ParseInt(kv[1], 10, 64); err != nil {
+				return ErrInvalidHeader
+			}
+		case signatureKey:
+			signature = kv[1]
+		}
+	}
+
+	if unix == 0 || signature == "" {
+		return ErrInvalidHeader
+	}
+
+	if tolerance > 0 {
+		if diff := now.Sub(time.Unix(unix, 0)); diff > tolerance || diff < -tolerance {
+			return ErrExpiredTimestamp
+		}
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(compute(secret, unix, payload))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func compute(secret []byte, unix int64, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(unix, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignValue returns token in format "<value>.<hex hmac-sha256 of value>".
+func SignValue(secret []byte, value string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(value))
+
+	return value + "." + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyValue checks token created by SignValue and returns signed value.
+func VerifyValue(secret []byte, token string) (string, error) {
+	separatorIndex := strings.LastIndex(token, ".")
+	if separatorIndex == -1 {
+		return "", ErrInvalidToken
+	}
+
+	value := token[:separatorIndex]
+	if !hmac.Equal([]byte(token), []byte(SignValue(secret, value))) {
+		return "", ErrInvalidSignature
+	}
+
+	return value, nil
+}
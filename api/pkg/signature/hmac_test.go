@@ -0,0 +1,123 @@
+package signature_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/signature"
+)
+
+func TestSignature_Verify(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("webhook secret")
+	payload := []byte(`{"type":"rating.changed"}`)
+	now := time.Unix(1625097600, 0)
+	header := signature.Sign(secret, now, payload)
+
+	testCases := []struct {
+		name    string
+		secret  []byte
+		header  string
+		payload []byte
+		now     time.Time
+		err     error
+	}{
+		{
+			name:    "valid signature",
+			secret:  secret,
+			header:  header,
+			payload: payload,
+			now:     now.Add(time.Minute),
+			err:     nil,
+		},
+		{
+			name:    "invalid secret",
+			secret:  []byte("another secret"),
+			header:  header,
+			payload: payload,
+			now:     now,
+			err:     signature.ErrInvalidSignature,
+		},
+		{
+			name:    "modified payload",
+			secret:  secret,
+			header:  header,
+			payload: []byte(`{"type":"rating.deleted"}`),
+			now:     now,
+			err:     signature.ErrInvalidSignature,
+		},
+		{
+			name:    "expired timestamp",
+			secret:  secret,
+			header:  header,
+			payload: payload,
+			now:     now.Add(time.Hour),
+			err:     signature.ErrExpiredTimestamp,
+		},
+		{
+			name:    "invalid header",
+			secret:  secret,
+			header:  "signature",
+			payload: payload,
+			now:     now,
+			err:     signature.ErrInvalidHeader,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := signature.Verify(tc.secret, tc.header, tc.payload, 5*time.Minute, tc.now)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestSignature_VerifyValue(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("links secret")
+	token := signature.SignValue(secret, "42")
+
+	testCases := []struct {
+		name  string
+		token string
+		value string
+		err   error
+	}{
+		{
+			name:  "valid token",
+			token: token,
+			value: "42",
+			err:   nil,
+		},
+		{
+			name:  "modified value",
+			token: "43" + token[2:],
+			value: "",
+			err:   signature.ErrInvalidSignature,
+		},
+		{
+			name:  "invalid format",
+			token: "42",
+			value: "",
+			err:   signature.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := signature.VerifyValue(secret, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
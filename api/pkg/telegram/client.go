@@ -0,0 +1,149 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+)
+
+// requestTimeout limits bot api requests. Long polling requests are extended by it,
+// so they don't time out before Bot API responds.
+const requestTimeout = 10 * time.Second
+
+type User struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+type Chat struct {
+	ID int64 `json:"id"`
+}
+
+type Message struct {
+	MessageID int    `json:"message_id"`
+	From      *User  `json:"from"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+type Update struct {
+	UpdateID int      `json:"update_id"`
+	Message  *Message `json:"message"`
+}
+
+type response struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result"`
+	Description string          `json:"description"`
+}
+
+type sendMessageRequest struct {
+	ChatID                int64  `json:"chat_id"`
+	Text                  string `json:"text"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+// Client is a minimal Telegram Bot API client. API URL is configurable,
+// so client can be used with local Bot API server or its fake.
+type Client struct {
+	client         http.Client
+	apiURL         string
+	token          string
+	pollingTimeout time.Duration
+}
+
+func NewClient(cfg *config.Telegram) *Client {
+	return &Client{
+		apiURL:         strings.TrimSuffix(cfg.APIURL, "/"),
+		token:          cfg.BotToken,
+		pollingTimeout: cfg.PollingTimeout,
+	}
+}
+
+func (c *Client) IsEnabled() bool {
+	return c.token != ""
+}
+
+// GetUpdates long polls updates with identifiers starting from offset.
+func (c *Client) GetUpdates(ctx context.Context, offset int) ([]Update, error) {
+	var updates []Update
+
+	uri := fmt.Sprintf(
+		"%s?offset=%d&timeout=%d", c.formatMethodURL("getUpdates"), offset, int(c.pollingTimeout.Seconds()),
+	)
+	if err := c.do(ctx, http.MethodGet, uri, nil, c.pollingTimeout+requestTimeout, &updates); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+// SendMessage sends text message to chat.
+func (c *Client) SendMessage(ctx context.Context, chatID int64, text string) error {
+	body, err := json.Marshal(sendMessageRequest{
+		ChatID:                chatID,
+		Text:                  text,
+		DisableWebPagePreview: true,
+	})
+	if err != nil {
+		return fmt.Errorf("error while marshaling message: %w", err)
+	}
+
+	return c.do(ctx, http.MethodPost, c.formatMethodURL("sendMessage"), body, requestTimeout, nil)
+}
+
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	uri string,
+	body []byte,
+	timeout time.Duration,
+	result interface{},
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, uri, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error while creating bot api request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error while calling bot api: %w", err)
+	}
+	defer res.Body.Close()
+
+	var r response
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return fmt.Errorf("error while unmarshaling bot api response: %w", err)
+	}
+
+	if !r.OK {
+		return fmt.Errorf("bot api responded with error: %s", r.Description)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Result, result); err != nil {
+		return fmt.Errorf("error while unmarshaling bot api result: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) formatMethodURL(method string) string {
+	return fmt.Sprintf("%s/bot%s/%s", c.apiURL, c.token, method)
+}
@@ -0,0 +1,99 @@
+package telegram_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/telegram"
+)
+
+const token = "123:token"
+
+func newFakeBotAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bot" + token + "/getUpdates":
+			assert.Equal(t, "42", r.URL.Query().Get("offset"))
+			_, _ = w.Write([]byte(
+				`{"ok":true,"result":[{"update_id":42,"message":{"message_id":1,"chat":{"id":7},"text":"/rating"}}]}`,
+			))
+		case "/bot" + token + "/sendMessage":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, float64(7), body["chat_id"])
+
+			if body["text"] == "" {
+				_, _ = w.Write([]byte(`{"ok":false,"description":"Bad Request: message text is empty"}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"ok":true,"result":{}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"ok":false,"description":"Not Found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClient_GetUpdates(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeBotAPI(t)
+
+	client := telegram.NewClient(&config.Telegram{APIURL: server.URL, BotToken: token, PollingTimeout: time.Second})
+
+	updates, err := client.GetUpdates(context.Background(), 42)
+	assert.NoError(t, err)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, 42, updates[0].UpdateID)
+	assert.Equal(t, int64(7), updates[0].Message.Chat.ID)
+	assert.Equal(t, "/rating", updates[0].Message.Text)
+}
+
+func TestClient_SendMessage(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeBotAPI(t)
+
+	client := telegram.NewClient(&config.Telegram{APIURL: server.URL, BotToken: token, PollingTimeout: time.Second})
+
+	testCases := []struct {
+		name  string
+		text  string
+		isErr bool
+	}{
+		{
+			name:  "message is sent",
+			text:  "hello",
+			isErr: false,
+		},
+		{
+			name:  "bot api error",
+			text:  "",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := client.SendMessage(context.Background(), 7, tc.text)
+			assert.Equal(t, tc.isErr, err != nil)
+		})
+	}
+}
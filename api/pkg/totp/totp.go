@@ -0,0 +1,121 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // nolint:gosec // HMAC-SHA1 is required by RFC 6238
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/random"
+)
+
+const (
+	// Period is time step of codes.
+	Period = 30 * time.Second
+	// Digits is length of codes.
+	Digits = 6
+
+	secretSize = 20
+	modulo     = 1000000 // 10^Digits
+)
+
+var (
+	ErrInvalidSecret = errors.New("invalid totp secret")
+	ErrInvalidCode   = errors.New("invalid totp code")
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns random base32 encoded secret of 160 bits.
+func GenerateSecret() (string, error) {
+	b, err := random.Bytes(secretSize)
+	if err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+// Step returns time step of moment t.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code returns code of secret at moment t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return code(key, Step(t)), nil
+}
+
+// Validate checks code of secret at moment t, codes of skew neighbouring steps are accepted too,
+// so clocks of server and device may differ a bit. It returns step of matched code, caller
+// should reject codes of already used steps, so code can't be replayed.
+func Validate(secret string, value string, t time.Time, skew int) (int64, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+
+	value = strings.ReplaceAll(value, " ", "")
+	if len(value) != Digits {
+		return 0, ErrInvalidCode
+	}
+
+	current := Step(t)
+	for i := -skew; i <= skew; i++ {
+		step := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(code(key, step)), []byte(value)) == 1 {
+			return step, nil
+		}
+	}
+
+	return 0, ErrInvalidCode
+}
+
+// ProvisioningURI returns otpauth uri of secret, authenticator apps import it from QR code.
+func ProvisioningURI(issuer string, account string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period/time.Second)))
+
+	label := url.PathEscape(issuer + ":" + account)
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return key, nil
+}
+
+// code is HOTP value of counter (RFC 4226) truncated to Digits.
+func code(key []byte, counter int64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo)
+}
@@ -0,0 +1,136 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/totp"
+)
+
+// rfcSecret is secret of RFC 6238 test vectors, codes are their last six digits.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		time int64
+		code string
+	}{
+		{name: "first step", time: 59, code: "287082"},
+		{name: "step before 2005", time: 1111111109, code: "081804"},
+		{name: "step after 2005", time: 1111111111, code: "050471"},
+		{name: "step of 2009", time: 1234567890, code: "005924"},
+		{name: "step of 2033", time: 2000000000, code: "279037"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := totp.Code(rfcSecret, time.Unix(tc.time, 0))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.code, code)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1111111111, 0)
+
+	testCases := []struct {
+		name   string
+		secret string
+		code   string
+		skew   int
+		step   int64
+		err    error
+	}{
+		{
+			name:   "current code",
+			secret: rfcSecret,
+			code:   "050471",
+			skew:   1,
+			step:   totp.Step(now),
+		},
+		{
+			name:   "code with spaces",
+			secret: rfcSecret,
+			code:   "050 471",
+			step:   totp.Step(now),
+		},
+		{
+			name:   "previous code in skew",
+			secret: rfcSecret,
+			code:   "081804",
+			skew:   1,
+			step:   totp.Step(now) - 1,
+		},
+		{
+			name:   "previous code without skew",
+			secret: rfcSecret,
+			code:   "081804",
+			err:    totp.ErrInvalidCode,
+		},
+		{
+			name:   "wrong code",
+			secret: rfcSecret,
+			code:   "000000",
+			skew:   1,
+			err:    totp.ErrInvalidCode,
+		},
+		{
+			name:   "short code",
+			secret: rfcSecret,
+			code:   "0504",
+			skew:   1,
+			err:    totp.ErrInvalidCode,
+		},
+		{
+			name:   "invalid secret",
+			secret: "not base32!",
+			code:   "050471",
+			err:    totp.ErrInvalidSecret,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			step, err := totp.Validate(tc.secret, tc.code, now, tc.skew)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.step, step)
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	t.Parallel()
+
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	code, err := totp.Code(secret, time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, code, totp.Digits)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		"otpauth://totp/RLMP:student%20one?algorithm=SHA1&digits=6&issuer=RLMP&period=30&secret=JBSWY3DPEHPK3PXP",
+		totp.ProvisioningURI("RLMP", "student one", "JBSWY3DPEHPK3PXP"),
+	)
+}
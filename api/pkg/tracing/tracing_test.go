@@ -0,0 +1,83 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/config"
+	"github.com/ythosa/rating-list-monitoring-platform-api/pkg/tracing"
+)
+
+func TestTracing_NewProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		cfg         config.Tracing
+		expectedErr bool
+	}{
+		{
+			name: "disabled",
+			cfg:  config.Tracing{Enabled: false, Exporter: "zipkin", ServiceName: "rlmp-api"},
+		},
+		{
+			name: "stdout exporter",
+			cfg:  config.Tracing{Enabled: true, Exporter: tracing.ExporterStdout, ServiceName: "rlmp-api", SampleRatio: 1},
+		},
+		{
+			name:        "unknown exporter",
+			cfg:         config.Tracing{Enabled: true, Exporter: "zipkin", ServiceName: "rlmp-api", SampleRatio: 1},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider, err := tracing.NewProvider(&tc.cfg)
+			assert.Equal(t, tc.expectedErr, err != nil)
+
+			if err == nil {
+				assert.Equal(t, tc.cfg.ServiceName, provider.ServiceName())
+				assert.NoError(t, provider.Shutdown(context.Background()))
+			}
+		})
+	}
+}
+
+func TestTracing_TraceID(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, tracing.TraceID(context.Background()))
+
+	provider := sdktrace.NewTracerProvider()
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	assert.Equal(t, span.SpanContext().TraceID().String(), tracing.TraceID(ctx))
+	assert.Len(t, tracing.TraceID(ctx), 32)
+}
+
+func TestTracing_Detach(t *testing.T) {
+	t.Parallel()
+
+	provider := sdktrace.NewTracerProvider()
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	detached := tracing.Detach(ctx)
+	assert.NoError(t, detached.Err())
+	assert.Equal(t, tracing.TraceID(ctx), tracing.TraceID(detached))
+}
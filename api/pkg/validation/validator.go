@@ -0,0 +1,28 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// fieldNameTags are tags which name fields in requests, in order of precedence.
+var fieldNameTags = []string{"json", "form", "uri"}
+
+// NewValidator returns validator which names fields of validation errors by their json or form
+// tags, so clients get names of fields as they are sent.
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range fieldNameTags {
+			if name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]; name != "" && name != "-" {
+				return name
+			}
+		}
+
+		return field.Name
+	})
+
+	return validate
+}
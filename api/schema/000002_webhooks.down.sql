@@ -0,0 +1,5 @@
+DROP TABLE webhook_deliveries;
+
+DROP TABLE webhooks;
+
+DROP TABLE ratings_history;
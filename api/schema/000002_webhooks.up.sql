@@ -0,0 +1,41 @@
+CREATE TABLE ratings_history
+(
+    id                      serial                                           not null unique,
+    user_id                 int references users (id) on delete cascade      not null,
+    direction_id            int references directions (id) on delete cascade not null,
+    position                int                                              not null,
+    score                   int                                              not null,
+    priority_one_upper      int                                              not null,
+    submitted_consent_upper int                                              not null,
+    budget_places           int                                              not null,
+    created_at              timestamp                                        not null default now()
+);
+
+CREATE INDEX ratings_history_user_direction_idx ON ratings_history (user_id, direction_id, created_at DESC);
+
+CREATE TABLE webhooks
+(
+    id         serial                                      not null unique,
+    user_id    int references users (id) on delete cascade not null,
+    url        varchar(2048)                               not null,
+    secret     varchar(255)                                not null,
+    created_at timestamp                                   not null default now()
+);
+
+CREATE TABLE webhook_deliveries
+(
+    id                 serial                                         not null unique,
+    webhook_id         int references webhooks (id) on delete cascade not null,
+    event_id           varchar(64)                                    not null,
+    event_type         varchar(64)                                    not null,
+    payload            text                                           not null,
+    status             varchar(16)                                    not null default 'pending',
+    attempts           int                                            not null default 0,
+    next_attempt_at    timestamp                                      not null default now(),
+    last_response_code int,
+    last_error         text,
+    created_at         timestamp                                      not null default now(),
+    delivered_at       timestamp
+);
+
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
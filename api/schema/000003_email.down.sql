@@ -0,0 +1,5 @@
+ALTER TABLE users
+    DROP COLUMN language,
+    DROP COLUMN email_notifications,
+    DROP COLUMN is_email_verified,
+    DROP COLUMN email;
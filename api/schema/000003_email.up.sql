@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN email               varchar(255) unique,
+    ADD COLUMN is_email_verified   boolean    not null default false,
+    ADD COLUMN email_notifications boolean    not null default true,
+    ADD COLUMN language            varchar(2) not null default 'ru';
@@ -0,0 +1,2 @@
+ALTER TABLE users
+    ADD COLUMN telegram_chat_id bigint unique;
@@ -0,0 +1,14 @@
+CREATE TABLE alert_rules
+(
+    id                serial                                           not null unique,
+    user_id           int references users (id) on delete cascade      not null,
+    direction_id      int references directions (id) on delete cascade not null,
+    metric            varchar(32)                                      not null,
+    operator          varchar(8)                                       not null,
+    threshold         int                                              not null,
+    cooldown          int                                              not null default 0,
+    last_triggered_at timestamp,
+    created_at        timestamp                                        not null default now()
+);
+
+CREATE INDEX alert_rules_user_direction_idx ON alert_rules (user_id, direction_id);
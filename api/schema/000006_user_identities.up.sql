@@ -0,0 +1,11 @@
+CREATE TABLE user_identities
+(
+    id         serial                                      not null unique,
+    user_id    int references users (id) on delete cascade not null,
+    provider   varchar(64)                                 not null,
+    subject    varchar(255)                                not null,
+    email      varchar(255),
+    created_at timestamp                                   not null default now(),
+    unique (provider, subject),
+    unique (user_id, provider)
+);
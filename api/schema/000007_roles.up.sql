@@ -0,0 +1,3 @@
+ALTER TABLE users
+    ADD COLUMN role varchar(16) not null default 'student'
+        check (role in ('student', 'counselor', 'admin'));
@@ -0,0 +1,12 @@
+CREATE TABLE audit_log
+(
+    id         serial       not null unique,
+    user_id    int,
+    action     varchar(64)  not null,
+    ip         varchar(64)  not null default '',
+    user_agent varchar(512) not null default '',
+    metadata   jsonb        not null default '{}',
+    created_at timestamp    not null default now()
+);
+
+CREATE INDEX audit_log_user_idx ON audit_log (user_id, created_at DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP COLUMN snils_hash,
+    DROP COLUMN snils_mask;
@@ -0,0 +1,3 @@
+ALTER TABLE users
+    ADD COLUMN snils_hash varchar(64) unique,
+    ADD COLUMN snils_mask varchar(14) not null default '';
@@ -0,0 +1,14 @@
+CREATE TABLE personal_access_tokens
+(
+    id           serial                                      not null unique,
+    user_id      int references users (id) on delete cascade not null,
+    name         varchar(64)                                 not null,
+    token_hash   varchar(64)                                 not null unique,
+    scopes       varchar(512)                                not null,
+    rate_limit   int                                         not null,
+    last_used_at timestamp,
+    expires_at   timestamp,
+    created_at   timestamp                                   not null default now()
+);
+
+CREATE INDEX personal_access_tokens_user_idx ON personal_access_tokens (user_id);
@@ -0,0 +1,17 @@
+CREATE TABLE user_totp
+(
+    user_id    int references users (id) on delete cascade not null unique,
+    secret     varchar(255)                                not null,
+    enabled    boolean                                     not null default false,
+    created_at timestamp                                   not null default now()
+);
+
+CREATE TABLE totp_backup_codes
+(
+    id        serial                                      not null unique,
+    user_id   int references users (id) on delete cascade not null,
+    code_hash varchar(64)                                 not null,
+    used_at   timestamp
+);
+
+CREATE INDEX totp_backup_codes_user_idx ON totp_backup_codes (user_id);
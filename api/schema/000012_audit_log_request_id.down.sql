@@ -0,0 +1,4 @@
+DROP INDEX audit_log_action_idx;
+DROP INDEX audit_log_created_at_idx;
+
+ALTER TABLE audit_log DROP COLUMN request_id;
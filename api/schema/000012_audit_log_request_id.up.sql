@@ -0,0 +1,4 @@
+ALTER TABLE audit_log ADD COLUMN request_id varchar(64) not null default '';
+
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at DESC);
+CREATE INDEX audit_log_action_idx ON audit_log (action, created_at DESC);
@@ -0,0 +1,5 @@
+ALTER TABLE users_universities
+    DROP CONSTRAINT users_universities_user_university_key;
+
+ALTER TABLE users_directions
+    DROP CONSTRAINT users_directions_user_direction_key;
@@ -0,0 +1,17 @@
+DELETE
+FROM users_directions a USING users_directions b
+WHERE a.id > b.id
+  AND a.user_id = b.user_id
+  AND a.direction_id = b.direction_id;
+
+DELETE
+FROM users_universities a USING users_universities b
+WHERE a.id > b.id
+  AND a.user_id = b.user_id
+  AND a.university_id = b.university_id;
+
+ALTER TABLE users_directions
+    ADD CONSTRAINT users_directions_user_direction_key UNIQUE (user_id, direction_id);
+
+ALTER TABLE users_universities
+    ADD CONSTRAINT users_universities_user_university_key UNIQUE (user_id, university_id);
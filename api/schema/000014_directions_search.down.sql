@@ -0,0 +1,3 @@
+DROP INDEX directions_university_idx;
+DROP INDEX directions_code_idx;
+DROP INDEX directions_name_trgm_idx;
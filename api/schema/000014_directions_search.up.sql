@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX directions_name_trgm_idx ON directions USING gin (name gin_trgm_ops);
+CREATE INDEX directions_code_idx ON directions (split_part(name, ' ', 1) text_pattern_ops);
+CREATE INDEX directions_university_idx ON directions (university_id, id);
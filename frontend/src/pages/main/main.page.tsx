@@ -22,6 +22,11 @@ export const MainPage = () => {
             console.log(e)
             authContext.logout()
         })
+
+        return directionsService.subscribeForUserWithRating((data) => {
+            setUniversities(data)
+            setLoading(false)
+        })
     }, [ authContext ])
 
     const loadingBanner = loading ? <Loader/> : null
@@ -17,6 +17,19 @@ export default class DirectionService extends API {
             },
         )
 
+        return this.mapUniversityDirections(data)
+    }
+
+    subscribeForUserWithRating = (onData: (data: UniversityDirectionsDTO[]) => void): (() => void) => {
+        const url = `${this.baseURL.replace(/^http/, 'ws')}/direction/live?access_token=${this.accessToken}`
+        const socket = new WebSocket(url)
+
+        socket.onmessage = (event) => onData(this.mapUniversityDirections(JSON.parse(event.data)))
+
+        return () => socket.close()
+    }
+
+    private mapUniversityDirections = (data: any): UniversityDirectionsDTO[] => {
         return data.map((u: any) => new UniversityDirectionsDTO(
             u.university_id, u.university_name, u.university_full_name,
             u.directions.map((d: any) => new DirectionWithRatingDTO(